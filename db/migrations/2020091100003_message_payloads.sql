@@ -0,0 +1,13 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+insert into chat_message_types values('buttons', 'набор кнопок');
+insert into chat_message_types values('quickReplies', 'быстрые ответы');
+insert into chat_message_types values('card', 'карточка');
+insert into chat_message_types values('form', 'форма');
+
+alter table chat_messages add column payload json null;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+alter table chat_messages drop column payload;
+delete from chat_message_types where code in ('buttons', 'quickReplies', 'card', 'form');
@@ -0,0 +1,40 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+alter table rooms add column queue varchar default '' not null;
+
+create table queue_operators
+(
+  id         uuid primary key,
+  queue      varchar not null,
+  account_id uuid not null,
+  available  smallint check(available in (0, 1)) not null,
+  created_at timestamp default CURRENT_TIMESTAMP not null,
+  updated_at timestamp default CURRENT_TIMESTAMP not null,
+  deleted_at timestamp null
+);
+
+alter table queue_operators add constraint uk_queue_operators_queue_acc unique (queue, account_id);
+create index idx_queue_operators_account_id on queue_operators(account_id);
+
+create table queue_items
+(
+  id                  uuid primary key,
+  queue               varchar not null,
+  room_id             uuid not null,
+  assigned_account_id uuid null,
+  assigned_at         timestamp null,
+  transfers           int default 0 not null,
+  created_at          timestamp default CURRENT_TIMESTAMP not null,
+  updated_at          timestamp default CURRENT_TIMESTAMP not null,
+  deleted_at          timestamp null
+);
+
+alter table queue_items add constraint uk_queue_items_room unique (room_id);
+create index idx_queue_items_queue on queue_items(queue);
+create index idx_queue_items_assigned_acc on queue_items(assigned_account_id);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+drop table queue_items;
+drop table queue_operators;
+alter table rooms drop column queue;
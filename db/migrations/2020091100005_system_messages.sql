@@ -0,0 +1,7 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+insert into chat_message_types values('system', 'системное сообщение');
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+delete from chat_message_types where code = 'system';
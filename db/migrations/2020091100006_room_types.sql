@@ -0,0 +1,16 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+create table room_types (
+  code varchar(64) primary key,
+  description varchar not null
+);
+insert into room_types values('direct', 'личная переписка двух аккаунтов');
+insert into room_types values('group', 'групповой чат');
+insert into room_types values('channel', 'канал, публикуют только администраторы');
+
+alter table rooms add column type varchar references room_types(code) default 'group' not null;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+alter table rooms drop column type;
+drop table room_types;
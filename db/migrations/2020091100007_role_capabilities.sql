@@ -0,0 +1,53 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+create table subscriber_roles
+(
+  code        varchar(64) primary key,
+  description varchar default '' not null,
+  created_at  timestamp default CURRENT_TIMESTAMP not null,
+  updated_at  timestamp default CURRENT_TIMESTAMP not null,
+  deleted_at  timestamp null
+);
+
+create table role_capabilities
+(
+  id         uuid primary key,
+  role       varchar(64) not null references subscriber_roles(code),
+  capability varchar(64) check(capability in ('send', 'send-private', 'edit-any', 'delete-any', 'invite', 'kick', 'close')) not null,
+  created_at timestamp default CURRENT_TIMESTAMP not null,
+  updated_at timestamp default CURRENT_TIMESTAMP not null,
+  deleted_at timestamp null
+);
+
+alter table role_capabilities add constraint uk_role_capabilities_role_cap unique (role, capability);
+
+insert into subscriber_roles(code, description) values('owner', 'владелец комнаты');
+insert into subscriber_roles(code, description) values('admin', 'администратор комнаты');
+insert into subscriber_roles(code, description) values('member', 'участник');
+insert into subscriber_roles(code, description) values('observer', 'наблюдатель');
+insert into subscriber_roles(code, description) values('operator', 'оператор');
+insert into subscriber_roles(code, description) values('client', 'клиент');
+insert into subscriber_roles(code, description) values('bot', 'бот');
+
+insert into role_capabilities(id, role, capability)
+  select md5(r.code || c.cap)::uuid, r.code, c.cap
+    from subscriber_roles r
+      cross join unnest(array['send', 'send-private', 'edit-any', 'delete-any', 'invite', 'kick', 'close']) as c(cap)
+    where r.code in ('owner', 'admin');
+
+insert into role_capabilities(id, role, capability)
+  select md5(r.code || c.cap)::uuid, r.code, c.cap
+    from subscriber_roles r
+      cross join unnest(array['send', 'send-private', 'invite', 'close']) as c(cap)
+    where r.code = 'operator';
+
+insert into role_capabilities(id, role, capability)
+  select md5(r.code || c.cap)::uuid, r.code, c.cap
+    from subscriber_roles r
+      cross join unnest(array['send', 'send-private']) as c(cap)
+    where r.code in ('member', 'client', 'bot');
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+drop table role_capabilities;
+drop table subscriber_roles;
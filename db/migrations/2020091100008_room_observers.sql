@@ -0,0 +1,7 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+alter table room_subscribers add column observer smallint check(observer in (0, 1)) default 0 not null;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+alter table room_subscribers drop column observer;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+alter table chat_messages add column visibility varchar(16) check(visibility in ('all', 'recipient', 'roles')) default 'all' not null;
+alter table chat_messages add column visible_roles jsonb null;
+
+update chat_messages set visibility = 'recipient' where recipient_account_id is not null;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+alter table chat_messages drop column visible_roles;
+alter table chat_messages drop column visibility;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+alter table rooms add column hash_expires_at timestamp null;
+alter table rooms add column hash_one_time smallint check(hash_one_time in (0, 1)) default 0 not null;
+alter table rooms add column hash_used_at timestamp null;
+
+create unique index uk_rooms_hash on rooms(hash) where hash <> '';
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+drop index uk_rooms_hash;
+alter table rooms drop column hash_used_at;
+alter table rooms drop column hash_one_time;
+alter table rooms drop column hash_expires_at;
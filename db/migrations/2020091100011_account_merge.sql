@@ -0,0 +1,11 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+insert into account_statuses values('merged', 'объединен с другим аккаунтом');
+
+alter table accounts add merged_into uuid null;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+alter table accounts drop column merged_into;
+
+delete from account_statuses where code = 'merged';
@@ -0,0 +1,15 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+alter table rooms add inactivity_timeout int null;
+alter table rooms add close_at timestamp null;
+alter table rooms add close_warned_at timestamp null;
+
+create index idx_rooms_auto_close on rooms(closed_at) where close_at is not null or inactivity_timeout > 0;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+drop index idx_rooms_auto_close;
+
+alter table rooms drop column close_warned_at;
+alter table rooms drop column close_at;
+alter table rooms drop column inactivity_timeout;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+alter table rooms add reopened_at timestamp null;
+alter table rooms add archived_at timestamp null;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+alter table rooms drop column archived_at;
+alter table rooms drop column reopened_at;
@@ -0,0 +1,34 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+alter table rooms add title varchar null;
+alter table rooms add description varchar null;
+alter table rooms add avatar_url varchar null;
+alter table rooms add attributes jsonb null;
+alter table rooms add tags jsonb null;
+
+create index idx_rooms_tags on rooms using gin(tags);
+
+alter table role_capabilities drop constraint role_capabilities_capability_check;
+alter table role_capabilities add constraint role_capabilities_capability_check
+  check(capability in ('send', 'send-private', 'edit-any', 'delete-any', 'invite', 'kick', 'close', 'edit-room'));
+
+insert into role_capabilities(id, role, capability)
+  select md5(r.code || 'edit-room')::uuid, r.code, 'edit-room'
+    from subscriber_roles r
+    where r.code in ('owner', 'admin', 'operator');
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+delete from role_capabilities where capability = 'edit-room';
+
+alter table role_capabilities drop constraint role_capabilities_capability_check;
+alter table role_capabilities add constraint role_capabilities_capability_check
+  check(capability in ('send', 'send-private', 'edit-any', 'delete-any', 'invite', 'kick', 'close'));
+
+drop index idx_rooms_tags;
+
+alter table rooms drop column tags;
+alter table rooms drop column attributes;
+alter table rooms drop column avatar_url;
+alter table rooms drop column description;
+alter table rooms drop column title;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+alter table rooms add last_message_at timestamp null;
+
+update rooms r set last_message_at = (select max(cm.created_at) from chat_messages cm where cm.room_id = r.id);
+
+create index idx_rooms_last_activity on rooms((coalesce(last_message_at, created_at)) desc, id desc);
+create index idx_chat_msg_room_created on chat_messages(room_id, created_at desc);
+create index idx_chat_mes_statuses_subscribe_status on chat_message_statuses(subscribe_id, status);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+drop index idx_chat_mes_statuses_subscribe_status;
+drop index idx_chat_msg_room_created;
+drop index idx_rooms_last_activity;
+
+alter table rooms drop column last_message_at;
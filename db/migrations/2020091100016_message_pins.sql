@@ -0,0 +1,47 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+create table room_pinned_messages
+(
+  id          uuid primary key,
+  room_id     uuid not null,
+  message_id  uuid not null,
+  account_id  uuid not null,
+  created_at  timestamp default CURRENT_TIMESTAMP not null,
+  updated_at  timestamp default CURRENT_TIMESTAMP not null,
+  deleted_at  timestamp null
+);
+
+alter table room_pinned_messages add constraint uk_room_pinned_messages_room_msg unique (room_id, message_id);
+
+create table chat_message_stars
+(
+  id          uuid primary key,
+  message_id  uuid not null,
+  account_id  uuid not null,
+  created_at  timestamp default CURRENT_TIMESTAMP not null,
+  updated_at  timestamp default CURRENT_TIMESTAMP not null,
+  deleted_at  timestamp null
+);
+
+alter table chat_message_stars add constraint uk_chat_message_stars_acc_msg unique (account_id, message_id);
+create index idx_chat_message_stars_message_id on chat_message_stars(message_id);
+
+alter table role_capabilities drop constraint role_capabilities_capability_check;
+alter table role_capabilities add constraint role_capabilities_capability_check
+  check(capability in ('send', 'send-private', 'edit-any', 'delete-any', 'invite', 'kick', 'close', 'edit-room', 'pin'));
+
+insert into role_capabilities(id, role, capability)
+  select md5(r.code || 'pin')::uuid, r.code, 'pin'
+    from subscriber_roles r
+    where r.code in ('owner', 'admin', 'operator');
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+delete from role_capabilities where capability = 'pin';
+
+alter table role_capabilities drop constraint role_capabilities_capability_check;
+alter table role_capabilities add constraint role_capabilities_capability_check
+  check(capability in ('send', 'send-private', 'edit-any', 'delete-any', 'invite', 'kick', 'close', 'edit-room'));
+
+drop table chat_message_stars;
+drop table room_pinned_messages;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+alter table chat_messages add forwarded_message_id uuid null;
+alter table chat_messages add forwarded_room_id uuid null;
+alter table chat_messages add forwarded_account_id uuid null;
+alter table chat_messages add forwarded_at timestamp null;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+alter table chat_messages drop column forwarded_at;
+alter table chat_messages drop column forwarded_account_id;
+alter table chat_messages drop column forwarded_room_id;
+alter table chat_messages drop column forwarded_message_id;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+create table scheduled_messages
+(
+  id          uuid primary key,
+  room_id     uuid not null,
+  account_id  uuid not null,
+  send_at     timestamp not null,
+  message     jsonb not null,
+  created_at  timestamp default CURRENT_TIMESTAMP not null,
+  updated_at  timestamp default CURRENT_TIMESTAMP not null,
+  deleted_at  timestamp null
+);
+
+create index idx_scheduled_messages_send_at on scheduled_messages(send_at);
+create index idx_scheduled_messages_room_id on scheduled_messages(room_id);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+drop table scheduled_messages;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+alter table chat_messages add expires_at timestamp null;
+alter table chat_messages add expired_at timestamp null;
+
+create index idx_chat_msg_expires_at on chat_messages(expires_at) where expired_at is null;
+
+alter table rooms add message_ttl int default 0 not null;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+alter table rooms drop column message_ttl;
+
+drop index idx_chat_msg_expires_at;
+
+alter table chat_messages drop column expired_at;
+alter table chat_messages drop column expires_at;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+create index idx_chat_msg_created_at on chat_messages(created_at);
+create index idx_chat_mes_statuses_created_at on chat_message_statuses(created_at);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+drop index idx_chat_mes_statuses_created_at;
+drop index idx_chat_msg_created_at;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+alter table accounts add erased_at timestamp null;
+
+create table account_data_requests
+(
+  id                    uuid primary key,
+  account_id            uuid not null,
+  type                  varchar(32) not null,
+  initiator_account_id  uuid null,
+  reason                varchar null,
+  created_at            timestamp default CURRENT_TIMESTAMP not null,
+  updated_at            timestamp default CURRENT_TIMESTAMP not null,
+  deleted_at            timestamp null
+);
+
+create index idx_account_data_requests_account_id on account_data_requests(account_id);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+drop table account_data_requests;
+
+alter table accounts drop column erased_at;
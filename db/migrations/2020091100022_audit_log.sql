@@ -0,0 +1,23 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+create table audit_log
+(
+  id                uuid primary key,
+  actor_account_id  uuid null,
+  action            varchar(64) not null,
+  target_type       varchar(32) not null,
+  target_id         uuid null,
+  before            jsonb null,
+  after             jsonb null,
+  created_at        timestamp default CURRENT_TIMESTAMP not null,
+  updated_at        timestamp default CURRENT_TIMESTAMP not null,
+  deleted_at        timestamp null
+);
+
+create index idx_audit_log_created_at on audit_log(created_at);
+create index idx_audit_log_target on audit_log(target_id);
+create index idx_audit_log_actor on audit_log(actor_account_id);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+drop table audit_log;
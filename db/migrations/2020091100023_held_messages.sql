@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+create table held_messages
+(
+  id          uuid primary key,
+  room_id     uuid not null,
+  account_id  uuid not null,
+  message     jsonb not null,
+  filter      varchar(64) not null,
+  reason      varchar(500) null,
+  created_at  timestamp default CURRENT_TIMESTAMP not null,
+  updated_at  timestamp default CURRENT_TIMESTAMP not null,
+  deleted_at  timestamp null
+);
+
+create index idx_held_messages_room_id on held_messages(room_id);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+drop table held_messages;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+create table message_reports
+(
+  id                  uuid primary key,
+  message_id          uuid not null,
+  room_id             uuid not null,
+  account_id          uuid not null,
+  reason              varchar(500) null,
+  status              varchar(20) default 'open' not null check(status in ('open', 'dismissed', 'deleted', 'locked')),
+  resolved_by         uuid null,
+  resolved_at         timestamp null,
+  comment             varchar(500) null,
+  created_at          timestamp default CURRENT_TIMESTAMP not null,
+  updated_at          timestamp default CURRENT_TIMESTAMP not null,
+  deleted_at          timestamp null
+);
+
+create unique index uk_message_reports_open on message_reports(message_id, account_id) where status = 'open';
+create index idx_message_reports_room_id on message_reports(room_id) where status = 'open';
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+drop table message_reports;
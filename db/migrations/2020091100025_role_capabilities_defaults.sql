@@ -0,0 +1,31 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- roles already used by subscribers get the default capabilities, so existing rooms keep working
+with seeded as (
+  insert into subscriber_roles(code, description)
+    select distinct rs.role, '' from room_subscribers rs where rs.role <> ''
+  on conflict (code) do nothing
+  returning code
+)
+insert into role_capabilities(id, role, capability)
+  select md5(s.code || c.cap)::uuid, s.code, c.cap
+    from seeded s
+      cross join unnest(array['send', 'send-private']) as c(cap);
+
+alter table role_capabilities drop constraint role_capabilities_capability_check;
+alter table role_capabilities add constraint role_capabilities_capability_check
+  check(capability in ('send', 'send-private', 'edit-any', 'delete-any', 'invite', 'kick', 'close', 'edit-room', 'pin', 'post'));
+
+insert into role_capabilities(id, role, capability)
+  select md5(r.code || 'post')::uuid, r.code, 'post'
+    from subscriber_roles r
+    where r.code in ('owner', 'admin');
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+delete from role_capabilities where capability = 'post';
+
+alter table role_capabilities drop constraint role_capabilities_capability_check;
+alter table role_capabilities add constraint role_capabilities_capability_check
+  check(capability in ('send', 'send-private', 'edit-any', 'delete-any', 'invite', 'kick', 'close', 'edit-room', 'pin'));
@@ -0,0 +1,7 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+alter table chat_messages add column moderated_at timestamp null;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+alter table chat_messages drop column moderated_at;
@@ -1,8 +1,10 @@
 package app
 
 import (
+	"encoding/json"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -22,3 +24,424 @@ func (e *Env) CronStep() time.Duration {
 	return time.Duration(cronStep) * time.Second
 }
 
+
+const (
+	QueueStrategyRoundRobin  = "roundRobin"
+	QueueStrategyLeastLoaded = "leastLoaded"
+
+	defaultQueueDispatchStep     = 5
+	defaultQueueOperatorMaxRooms = 1
+)
+
+// QueueStrategy defines how the dispatcher picks an operator for a queued room
+func (e *Env) QueueStrategy() string {
+	if os.Getenv("QUEUE_STRATEGY") == QueueStrategyLeastLoaded {
+		return QueueStrategyLeastLoaded
+	}
+	return QueueStrategyRoundRobin
+}
+
+func (e *Env) QueueDispatchStep() time.Duration {
+	step, err := strconv.ParseInt(os.Getenv("QUEUE_DISPATCH_STEP"), 10, 0)
+	if err != nil || step <= 0 {
+		step = defaultQueueDispatchStep
+	}
+
+	return time.Duration(step) * time.Second
+}
+
+// QueueOperatorMaxRooms is a max number of open rooms an operator can be assigned to (0 - unlimited)
+func (e *Env) QueueOperatorMaxRooms() int {
+	maxRooms, err := strconv.Atoi(os.Getenv("QUEUE_OPERATOR_MAX_ROOMS"))
+	if err != nil || maxRooms < 0 {
+		maxRooms = defaultQueueOperatorMaxRooms
+	}
+
+	return maxRooms
+}
+
+// MultipleOpenRooms checks if an account subscribed with the role can have more than one open room at a time
+// roles are listed in ROOM_MULTIPLE_OPEN_ROLES separated by comma, "*" allows it for all roles
+// by default a new room closes all the open rooms of its subscribers
+func (e *Env) MultipleOpenRooms(role string) bool {
+	for _, r := range strings.Split(os.Getenv("ROOM_MULTIPLE_OPEN_ROLES"), ",") {
+		r = strings.TrimSpace(r)
+		if r == "*" || (r != "" && r == role) {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	defaultAnonymousRole = "client"
+)
+
+// AnonymousRole is a role of anonymous accounts joining a room by its hash
+func (e *Env) AnonymousRole() string {
+	if role := os.Getenv("ANONYMOUS_ROLE"); role != "" {
+		return role
+	}
+	return defaultAnonymousRole
+}
+
+const (
+	defaultAnonymousTokenTtl = 86400
+)
+
+// AnonymousTokenTtl is a lifetime of the token issued to the anonymous account joining a room by its hash (0 - the token doesn't expire)
+func (e *Env) AnonymousTokenTtl() time.Duration {
+	ttl, err := strconv.ParseInt(os.Getenv("ANONYMOUS_TOKEN_TTL"), 10, 0)
+	if err != nil || ttl < 0 {
+		ttl = defaultAnonymousTokenTtl
+	}
+
+	return time.Duration(ttl) * time.Second
+}
+
+// RoomHashTtl is a default lifetime of the room's hash (0 - the hash doesn't expire)
+func (e *Env) RoomHashTtl() time.Duration {
+	ttl, err := strconv.ParseInt(os.Getenv("ROOM_HASH_TTL"), 10, 0)
+	if err != nil || ttl < 0 {
+		ttl = 0
+	}
+
+	return time.Duration(ttl) * time.Second
+}
+
+const (
+	defaultMultipleOpenRoomTypes = "direct,channel"
+)
+
+// MultipleOpenRoomTypes retrieves room types which don't close other open rooms of their subscribers
+// and aren't closed by other rooms (ROOM_MULTIPLE_OPEN_TYPES separated by comma, direct and channel if not set)
+func (e *Env) MultipleOpenRoomTypes() []string {
+	value, ok := os.LookupEnv("ROOM_MULTIPLE_OPEN_TYPES")
+	if !ok {
+		value = defaultMultipleOpenRoomTypes
+	}
+
+	var types []string
+	for _, t := range strings.Split(value, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+const (
+	defaultRoomCloseWarning = 60
+	defaultRoomCloserStep   = 10
+)
+
+// RoomInactivityTimeout is a default timeout after the last message the room is closed in (0 - the room isn't closed)
+func (e *Env) RoomInactivityTimeout() time.Duration {
+	timeout, err := strconv.ParseInt(os.Getenv("ROOM_INACTIVITY_TIMEOUT"), 10, 0)
+	if err != nil || timeout < 0 {
+		timeout = 0
+	}
+
+	return time.Duration(timeout) * time.Second
+}
+
+// RoomCloseWarning is a period before closing the room when subscribers are warned
+func (e *Env) RoomCloseWarning() time.Duration {
+	warning, err := strconv.ParseInt(os.Getenv("ROOM_CLOSE_WARNING"), 10, 0)
+	if err != nil || warning < 0 {
+		warning = defaultRoomCloseWarning
+	}
+
+	return time.Duration(warning) * time.Second
+}
+
+func (e *Env) RoomCloserStep() time.Duration {
+	step, err := strconv.ParseInt(os.Getenv("ROOM_CLOSER_STEP"), 10, 0)
+	if err != nil || step <= 0 {
+		step = defaultRoomCloserStep
+	}
+
+	return time.Duration(step) * time.Second
+}
+
+const (
+	defaultRoomReopenPeriod = 7
+)
+
+// RoomReopenPeriod is a period after closing the room can be reopened in (0 - unlimited)
+func (e *Env) RoomReopenPeriod() time.Duration {
+	days, err := strconv.ParseInt(os.Getenv("ROOM_REOPEN_PERIOD"), 10, 0)
+	if err != nil || days < 0 {
+		days = defaultRoomReopenPeriod
+	}
+
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// RoomArchiveAfter is a period after closing the room is archived in (0 - rooms aren't archived automatically)
+func (e *Env) RoomArchiveAfter() time.Duration {
+	days, err := strconv.ParseInt(os.Getenv("ROOM_ARCHIVE_AFTER"), 10, 0)
+	if err != nil || days < 0 {
+		days = 0
+	}
+
+	return time.Duration(days) * 24 * time.Hour
+}
+
+const (
+	defaultRoomPinsLimit = 10
+)
+
+// RoomPinsLimit is a max number of messages pinned in a room
+func (e *Env) RoomPinsLimit() int {
+	limit, err := strconv.ParseInt(os.Getenv("ROOM_PINS_LIMIT"), 10, 0)
+	if err != nil || limit <= 0 {
+		limit = defaultRoomPinsLimit
+	}
+
+	return int(limit)
+}
+
+const (
+	defaultMessageSchedulerStep = 10
+)
+
+// MessageSchedulerStep is a period of checking scheduled messages to be sent
+func (e *Env) MessageSchedulerStep() time.Duration {
+	step, err := strconv.ParseInt(os.Getenv("MESSAGE_SCHEDULER_STEP"), 10, 0)
+	if err != nil || step <= 0 {
+		step = defaultMessageSchedulerStep
+	}
+
+	return time.Duration(step) * time.Second
+}
+
+const (
+	defaultMessageSweeperStep = 10
+)
+
+// MessageSweeperStep is a period of checking expired messages to be wiped out
+func (e *Env) MessageSweeperStep() time.Duration {
+	step, err := strconv.ParseInt(os.Getenv("MESSAGE_SWEEPER_STEP"), 10, 0)
+	if err != nil || step <= 0 {
+		step = defaultMessageSweeperStep
+	}
+
+	return time.Duration(step) * time.Second
+}
+
+// RetentionPolicy defines how long messages and statuses of the matching rooms are kept
+type RetentionPolicy struct {
+	// rooms of the type only (any type if empty)
+	RoomType        string `json:"roomType"`
+	// rooms with the reference id starting with the prefix only (any reference if empty)
+	ReferencePrefix string `json:"referencePrefix"`
+	// messages older than the number of days are deleted (0 - kept forever)
+	MessagesDays    int    `json:"messagesDays"`
+	// message statuses older than the number of days are deleted (0 - kept forever)
+	StatusesDays    int    `json:"statusesDays"`
+}
+
+const (
+	defaultRetentionPurgeStep = 3600
+	defaultRetentionBatchSize = 1000
+)
+
+// RetentionPolicies are retrieved from RETENTION_POLICIES as a JSON array
+// the first policy matching the room is applied
+func (e *Env) RetentionPolicies() ([]RetentionPolicy, error) {
+	var policies []RetentionPolicy
+
+	value := os.Getenv("RETENTION_POLICIES")
+	if value == "" {
+		return policies, nil
+	}
+
+	if err := json.Unmarshal([]byte(value), &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// RetentionPurgeStep is a period of purging data by the retention policies
+func (e *Env) RetentionPurgeStep() time.Duration {
+	step, err := strconv.ParseInt(os.Getenv("RETENTION_PURGE_STEP"), 10, 0)
+	if err != nil || step <= 0 {
+		step = defaultRetentionPurgeStep
+	}
+
+	return time.Duration(step) * time.Second
+}
+
+// RetentionBatchSize is a max number of rows deleted at once
+func (e *Env) RetentionBatchSize() int {
+	size, err := strconv.Atoi(os.Getenv("RETENTION_BATCH_SIZE"))
+	if err != nil || size <= 0 {
+		size = defaultRetentionBatchSize
+	}
+
+	return size
+}
+
+// RetentionDryRun means the purge job only reports what would be deleted
+func (e *Env) RetentionDryRun() bool {
+	return os.Getenv("RETENTION_DRY_RUN") == "1"
+}
+
+// AuditLogRetentionDays is how long the audit log is kept regardless of the retention policies (0 - forever)
+func (e *Env) AuditLogRetentionDays() int {
+	days, err := strconv.Atoi(os.Getenv("AUDIT_LOG_RETENTION_DAYS"))
+	if err != nil || days < 0 {
+		days = 0
+	}
+
+	return days
+}
+
+const (
+	ModerationAllow  = "allow"
+	ModerationMask   = "mask"
+	ModerationHold   = "hold"
+	ModerationReject = "reject"
+
+	defaultModerationHookTimeout = 2000
+)
+
+// moderationAction retrieves the filter's action from the variable falling back to the default one
+func moderationAction(name string, defaultAction string) string {
+	switch action := os.Getenv(name); action {
+	case ModerationAllow, ModerationMask, ModerationHold, ModerationReject:
+		return action
+	}
+	return defaultAction
+}
+
+// ModerationFilters retrieves the ordered chain of moderation filters (MODERATION_FILTERS separated by comma)
+func (e *Env) ModerationFilters() []string {
+	var filters []string
+	for _, f := range strings.Split(os.Getenv("MODERATION_FILTERS"), ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			filters = append(filters, f)
+		}
+	}
+	return filters
+}
+
+// ModerationWordlists are retrieved from MODERATION_WORDLISTS as a JSON object of word patterns by language
+// e.g. {"ru": ["дурак\\p{L}*"], "en": ["fool"]}
+func (e *Env) ModerationWordlists() (map[string][]string, error) {
+	lists := make(map[string][]string)
+
+	value := os.Getenv("MODERATION_WORDLISTS")
+	if value == "" {
+		return lists, nil
+	}
+
+	if err := json.Unmarshal([]byte(value), &lists); err != nil {
+		return nil, err
+	}
+
+	return lists, nil
+}
+
+func (e *Env) ModerationWordlistAction() string {
+	return moderationAction("MODERATION_WORDLIST_ACTION", ModerationMask)
+}
+
+func (e *Env) ModerationLinksAction() string {
+	return moderationAction("MODERATION_LINKS_ACTION", ModerationReject)
+}
+
+// ModerationLinksAllowed retrieves domains links to which are allowed (MODERATION_LINKS_ALLOWED separated by comma)
+func (e *Env) ModerationLinksAllowed() []string {
+	var domains []string
+	for _, d := range strings.Split(os.Getenv("MODERATION_LINKS_ALLOWED"), ",") {
+		if d = strings.ToLower(strings.TrimSpace(d)); d != "" {
+			domains = append(domains, d)
+		}
+	}
+	return domains
+}
+
+func (e *Env) ModerationContactsAction() string {
+	return moderationAction("MODERATION_CONTACTS_ACTION", ModerationMask)
+}
+
+// ModerationHookUrl is an URL of the external moderation webhook
+func (e *Env) ModerationHookUrl() string {
+	return os.Getenv("MODERATION_HOOK_URL")
+}
+
+// ModerationHookSubject is a NATS subject of the external moderation service (used if the webhook isn't set)
+func (e *Env) ModerationHookSubject() string {
+	return os.Getenv("MODERATION_HOOK_SUBJECT")
+}
+
+func (e *Env) ModerationHookTimeout() time.Duration {
+	timeout, err := strconv.ParseInt(os.Getenv("MODERATION_HOOK_TIMEOUT"), 10, 0)
+	if err != nil || timeout <= 0 {
+		timeout = defaultModerationHookTimeout
+	}
+
+	return time.Duration(timeout) * time.Millisecond
+}
+
+// ModerationHookFailAction is applied when the external service doesn't respond in time or fails
+func (e *Env) ModerationHookFailAction() string {
+	action := moderationAction("MODERATION_HOOK_FAIL_ACTION", ModerationAllow)
+	if action == ModerationMask {
+		return ModerationAllow
+	}
+	return action
+}
+
+const (
+	defaultRateLimitAccountRate     = 10
+	defaultRateLimitAccountBurst    = 20
+	defaultRateLimitRoomRate        = 30
+	defaultRateLimitRoomBurst       = 60
+	defaultRateLimitConnectionBurst = 100
+)
+
+// RateLimit is a token bucket: Rate tokens per second are added up to Burst tokens
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// Enabled returns false if the rate is set to 0
+func (l RateLimit) Enabled() bool {
+	return l.Rate > 0 && l.Burst > 0
+}
+
+// rateLimit retrieves the limit from NAME_RATE, NAME_BURST variables falling back to the default ones
+func rateLimit(name string, defaultRate float64, defaultBurst int) RateLimit {
+
+	rate, err := strconv.ParseFloat(os.Getenv(name+"_RATE"), 64)
+	if err != nil || rate < 0 {
+		rate = defaultRate
+	}
+
+	burst, err := strconv.ParseInt(os.Getenv(name+"_BURST"), 10, 0)
+	if err != nil || burst <= 0 {
+		burst = int64(defaultBurst)
+	}
+
+	return RateLimit{Rate: rate, Burst: int(burst)}
+}
+
+// AccountRateLimit limits messages sent by the account via WebSocket (on all the nodes)
+func (e *Env) AccountRateLimit() RateLimit {
+	return rateLimit("RATE_LIMIT_ACCOUNT", defaultRateLimitAccountRate, defaultRateLimitAccountBurst)
+}
+
+// RoomRateLimit limits messages sent to the room via WebSocket
+func (e *Env) RoomRateLimit() RateLimit {
+	return rateLimit("RATE_LIMIT_ROOM", defaultRateLimitRoomRate, defaultRateLimitRoomBurst)
+}
+
+// ConnectionRateLimit limits WebSocket connections to the cluster (disabled by default)
+func (e *Env) ConnectionRateLimit() RateLimit {
+	return rateLimit("RATE_LIMIT_CONNECTION", 0, defaultRateLimitConnectionBurst)
+}
@@ -23,6 +23,9 @@ type AccountClient interface {
 	GetByCriteria(ctx context.Context, in *GetAccountsByCriteriaRequest, opts ...grpc.CallOption) (*GetAccountsByCriteriaResponse, error)
 	SetOnlineStatus(ctx context.Context, in *SetOnlineStatusRequest, opts ...grpc.CallOption) (*SetOnlineStatusResponse, error)
 	GetOnlineStatus(ctx context.Context, in *GetOnlineStatusRequest, opts ...grpc.CallOption) (*GetOnlineStatusResponse, error)
+	Merge(ctx context.Context, in *MergeAccountsRequest, opts ...grpc.CallOption) (*MergeAccountsResponse, error)
+	ExportData(ctx context.Context, in *AccountDataRequest, opts ...grpc.CallOption) (*ExportAccountDataResponse, error)
+	EraseData(ctx context.Context, in *AccountDataRequest, opts ...grpc.CallOption) (*EraseAccountDataResponse, error)
 }
 
 type accountClient struct {
@@ -87,6 +90,33 @@ func (c *accountClient) GetOnlineStatus(ctx context.Context, in *GetOnlineStatus
 	return out, nil
 }
 
+func (c *accountClient) Merge(ctx context.Context, in *MergeAccountsRequest, opts ...grpc.CallOption) (*MergeAccountsResponse, error) {
+	out := new(MergeAccountsResponse)
+	err := c.cc.Invoke(ctx, "/proto.Account/Merge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountClient) ExportData(ctx context.Context, in *AccountDataRequest, opts ...grpc.CallOption) (*ExportAccountDataResponse, error) {
+	out := new(ExportAccountDataResponse)
+	err := c.cc.Invoke(ctx, "/proto.Account/ExportData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountClient) EraseData(ctx context.Context, in *AccountDataRequest, opts ...grpc.CallOption) (*EraseAccountDataResponse, error) {
+	out := new(EraseAccountDataResponse)
+	err := c.cc.Invoke(ctx, "/proto.Account/EraseData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServer is the server API for Account service.
 // All implementations must embed UnimplementedAccountServer
 // for forward compatibility
@@ -97,6 +127,9 @@ type AccountServer interface {
 	GetByCriteria(context.Context, *GetAccountsByCriteriaRequest) (*GetAccountsByCriteriaResponse, error)
 	SetOnlineStatus(context.Context, *SetOnlineStatusRequest) (*SetOnlineStatusResponse, error)
 	GetOnlineStatus(context.Context, *GetOnlineStatusRequest) (*GetOnlineStatusResponse, error)
+	Merge(context.Context, *MergeAccountsRequest) (*MergeAccountsResponse, error)
+	ExportData(context.Context, *AccountDataRequest) (*ExportAccountDataResponse, error)
+	EraseData(context.Context, *AccountDataRequest) (*EraseAccountDataResponse, error)
 	mustEmbedUnimplementedAccountServer()
 }
 
@@ -122,6 +155,15 @@ func (UnimplementedAccountServer) SetOnlineStatus(context.Context, *SetOnlineSta
 func (UnimplementedAccountServer) GetOnlineStatus(context.Context, *GetOnlineStatusRequest) (*GetOnlineStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOnlineStatus not implemented")
 }
+func (UnimplementedAccountServer) Merge(context.Context, *MergeAccountsRequest) (*MergeAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Merge not implemented")
+}
+func (UnimplementedAccountServer) ExportData(context.Context, *AccountDataRequest) (*ExportAccountDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportData not implemented")
+}
+func (UnimplementedAccountServer) EraseData(context.Context, *AccountDataRequest) (*EraseAccountDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseData not implemented")
+}
 func (UnimplementedAccountServer) mustEmbedUnimplementedAccountServer() {}
 
 // UnsafeAccountServer may be embedded to opt out of forward compatibility for this service.
@@ -243,6 +285,60 @@ func _Account_GetOnlineStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Account_Merge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).Merge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Account/Merge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).Merge(ctx, req.(*MergeAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_ExportData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).ExportData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Account/ExportData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).ExportData(ctx, req.(*AccountDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_EraseData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).EraseData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Account/EraseData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).EraseData(ctx, req.(*AccountDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Account_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto.Account",
 	HandlerType: (*AccountServer)(nil),
@@ -271,6 +367,18 @@ var _Account_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetOnlineStatus",
 			Handler:    _Account_GetOnlineStatus_Handler,
 		},
+		{
+			MethodName: "Merge",
+			Handler:    _Account_Merge_Handler,
+		},
+		{
+			MethodName: "ExportData",
+			Handler:    _Account_ExportData_Handler,
+		},
+		{
+			MethodName: "EraseData",
+			Handler:    _Account_EraseData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "accountService.proto",
@@ -33,6 +33,7 @@ type SubscriberRequest struct {
 	Account         *AccountIdRequest `protobuf:"bytes,1,opt,name=Account,proto3" json:"Account,omitempty"`
 	Role            string            `protobuf:"bytes,2,opt,name=Role,proto3" json:"Role,omitempty"`
 	AsSystemAccount bool              `protobuf:"varint,3,opt,name=AsSystemAccount,proto3" json:"AsSystemAccount,omitempty"`
+	AsObserver      bool              `protobuf:"varint,4,opt,name=AsObserver,proto3" json:"AsObserver,omitempty"`
 }
 
 func (x *SubscriberRequest) Reset() {
@@ -88,6 +89,13 @@ func (x *SubscriberRequest) GetAsSystemAccount() bool {
 	return false
 }
 
+func (x *SubscriberRequest) GetAsObserver() bool {
+	if x != nil {
+		return x.AsObserver
+	}
+	return false
+}
+
 type RoomResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -148,11 +156,25 @@ type CreateRoomRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReferenceId string               `protobuf:"bytes,1,opt,name=ReferenceId,proto3" json:"ReferenceId,omitempty"`
-	Chat        bool                 `protobuf:"varint,2,opt,name=Chat,proto3" json:"Chat,omitempty"`
-	Video       bool                 `protobuf:"varint,3,opt,name=Video,proto3" json:"Video,omitempty"`
-	Audio       bool                 `protobuf:"varint,4,opt,name=Audio,proto3" json:"Audio,omitempty"`
-	Subscribers []*SubscriberRequest `protobuf:"bytes,5,rep,name=Subscribers,proto3" json:"Subscribers,omitempty"`
+	ReferenceId       string               `protobuf:"bytes,1,opt,name=ReferenceId,proto3" json:"ReferenceId,omitempty"`
+	Chat              bool                 `protobuf:"varint,2,opt,name=Chat,proto3" json:"Chat,omitempty"`
+	Video             bool                 `protobuf:"varint,3,opt,name=Video,proto3" json:"Video,omitempty"`
+	Audio             bool                 `protobuf:"varint,4,opt,name=Audio,proto3" json:"Audio,omitempty"`
+	Subscribers       []*SubscriberRequest `protobuf:"bytes,5,rep,name=Subscribers,proto3" json:"Subscribers,omitempty"`
+	Queue             string               `protobuf:"bytes,6,opt,name=Queue,proto3" json:"Queue,omitempty"`
+	Type              string               `protobuf:"bytes,7,opt,name=Type,proto3" json:"Type,omitempty"`
+	HashTtl           int64                `protobuf:"varint,8,opt,name=HashTtl,proto3" json:"HashTtl,omitempty"`
+	HashOneTime       bool                 `protobuf:"varint,9,opt,name=HashOneTime,proto3" json:"HashOneTime,omitempty"`
+	InactivityTimeout int64                `protobuf:"varint,10,opt,name=InactivityTimeout,proto3" json:"InactivityTimeout,omitempty"`
+	CloseAt           *Timestamp           `protobuf:"bytes,11,opt,name=CloseAt,proto3" json:"CloseAt,omitempty"`
+	Title             string               `protobuf:"bytes,12,opt,name=Title,proto3" json:"Title,omitempty"`
+	Description       string               `protobuf:"bytes,13,opt,name=Description,proto3" json:"Description,omitempty"`
+	AvatarUrl         string               `protobuf:"bytes,14,opt,name=AvatarUrl,proto3" json:"AvatarUrl,omitempty"`
+	// JSON object
+	Attributes         string   `protobuf:"bytes,15,opt,name=Attributes,proto3" json:"Attributes,omitempty"`
+	Tags               []string `protobuf:"bytes,16,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	MessageTtl         int64    `protobuf:"varint,17,opt,name=MessageTtl,proto3" json:"MessageTtl,omitempty"`
+	InitiatorAccountId *UUID    `protobuf:"bytes,18,opt,name=InitiatorAccountId,proto3" json:"InitiatorAccountId,omitempty"`
 }
 
 func (x *CreateRoomRequest) Reset() {
@@ -222,6 +244,97 @@ func (x *CreateRoomRequest) GetSubscribers() []*SubscriberRequest {
 	return nil
 }
 
+func (x *CreateRoomRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetHashTtl() int64 {
+	if x != nil {
+		return x.HashTtl
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetHashOneTime() bool {
+	if x != nil {
+		return x.HashOneTime
+	}
+	return false
+}
+
+func (x *CreateRoomRequest) GetInactivityTimeout() int64 {
+	if x != nil {
+		return x.InactivityTimeout
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetCloseAt() *Timestamp {
+	if x != nil {
+		return x.CloseAt
+	}
+	return nil
+}
+
+func (x *CreateRoomRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetAttributes() string {
+	if x != nil {
+		return x.Attributes
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateRoomRequest) GetMessageTtl() int64 {
+	if x != nil {
+		return x.MessageTtl
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetInitiatorAccountId() *UUID {
+	if x != nil {
+		return x.InitiatorAccountId
+	}
+	return nil
+}
+
 type CreateRoomResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -286,6 +399,7 @@ type GetSubscriberResponse struct {
 	AccountId     *UUID      `protobuf:"bytes,2,opt,name=AccountId,proto3" json:"AccountId,omitempty"`
 	Role          string     `protobuf:"bytes,3,opt,name=Role,proto3" json:"Role,omitempty"`
 	UnSubscribeAt *Timestamp `protobuf:"bytes,4,opt,name=UnSubscribeAt,proto3" json:"UnSubscribeAt,omitempty"`
+	Observer      bool       `protobuf:"varint,5,opt,name=Observer,proto3" json:"Observer,omitempty"`
 }
 
 func (x *GetSubscriberResponse) Reset() {
@@ -348,6 +462,13 @@ func (x *GetSubscriberResponse) GetUnSubscribeAt() *Timestamp {
 	return nil
 }
 
+func (x *GetSubscriberResponse) GetObserver() bool {
+	if x != nil {
+		return x.Observer
+	}
+	return false
+}
+
 type GetRoomResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -361,6 +482,16 @@ type GetRoomResponse struct {
 	Audio       bool                     `protobuf:"varint,6,opt,name=Audio,proto3" json:"Audio,omitempty"`
 	ClosedAt    *Timestamp               `protobuf:"bytes,7,opt,name=ClosedAt,proto3" json:"ClosedAt,omitempty"`
 	Subscribers []*GetSubscriberResponse `protobuf:"bytes,8,rep,name=Subscribers,proto3" json:"Subscribers,omitempty"`
+	Queue       string                   `protobuf:"bytes,9,opt,name=Queue,proto3" json:"Queue,omitempty"`
+	Type        string                   `protobuf:"bytes,10,opt,name=Type,proto3" json:"Type,omitempty"`
+	ArchivedAt  *Timestamp               `protobuf:"bytes,11,opt,name=ArchivedAt,proto3" json:"ArchivedAt,omitempty"`
+	Title       string                   `protobuf:"bytes,12,opt,name=Title,proto3" json:"Title,omitempty"`
+	Description string                   `protobuf:"bytes,13,opt,name=Description,proto3" json:"Description,omitempty"`
+	AvatarUrl   string                   `protobuf:"bytes,14,opt,name=AvatarUrl,proto3" json:"AvatarUrl,omitempty"`
+	Attributes  string                   `protobuf:"bytes,15,opt,name=Attributes,proto3" json:"Attributes,omitempty"`
+	Tags        []string                 `protobuf:"bytes,16,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	Pins        []*PinnedMessage         `protobuf:"bytes,17,rep,name=Pins,proto3" json:"Pins,omitempty"`
+	MessageTtl  int64                    `protobuf:"varint,18,opt,name=MessageTtl,proto3" json:"MessageTtl,omitempty"`
 }
 
 func (x *GetRoomResponse) Reset() {
@@ -451,20 +582,92 @@ func (x *GetRoomResponse) GetSubscribers() []*GetSubscriberResponse {
 	return nil
 }
 
-type GetRoomsByCriteriaRequest struct {
+func (x *GetRoomResponse) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *GetRoomResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetRoomResponse) GetArchivedAt() *Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *GetRoomResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetRoomResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetRoomResponse) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *GetRoomResponse) GetAttributes() string {
+	if x != nil {
+		return x.Attributes
+	}
+	return ""
+}
+
+func (x *GetRoomResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetRoomResponse) GetPins() []*PinnedMessage {
+	if x != nil {
+		return x.Pins
+	}
+	return nil
+}
+
+func (x *GetRoomResponse) GetMessageTtl() int64 {
+	if x != nil {
+		return x.MessageTtl
+	}
+	return 0
+}
+
+type PinnedMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReferenceId     string            `protobuf:"bytes,1,opt,name=ReferenceId,proto3" json:"ReferenceId,omitempty"`
-	AccountId       *AccountIdRequest `protobuf:"bytes,2,opt,name=AccountId,proto3" json:"AccountId,omitempty"`
-	RoomId          *UUID             `protobuf:"bytes,3,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
-	WithClosed      bool              `protobuf:"varint,4,opt,name=WithClosed,proto3" json:"WithClosed,omitempty"`
-	WithSubscribers bool              `protobuf:"varint,5,opt,name=WithSubscribers,proto3" json:"WithSubscribers,omitempty"`
+	MessageId       *UUID      `protobuf:"bytes,1,opt,name=MessageId,proto3" json:"MessageId,omitempty"`
+	Type            string     `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Text            string     `protobuf:"bytes,3,opt,name=Text,proto3" json:"Text,omitempty"`
+	SenderAccountId *UUID      `protobuf:"bytes,4,opt,name=SenderAccountId,proto3" json:"SenderAccountId,omitempty"`
+	CreatedAt       *Timestamp `protobuf:"bytes,5,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	PinnedBy        *UUID      `protobuf:"bytes,6,opt,name=PinnedBy,proto3" json:"PinnedBy,omitempty"`
+	PinnedAt        *Timestamp `protobuf:"bytes,7,opt,name=PinnedAt,proto3" json:"PinnedAt,omitempty"`
 }
 
-func (x *GetRoomsByCriteriaRequest) Reset() {
-	*x = GetRoomsByCriteriaRequest{}
+func (x *PinnedMessage) Reset() {
+	*x = PinnedMessage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roomService_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,13 +675,13 @@ func (x *GetRoomsByCriteriaRequest) Reset() {
 	}
 }
 
-func (x *GetRoomsByCriteriaRequest) String() string {
+func (x *PinnedMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoomsByCriteriaRequest) ProtoMessage() {}
+func (*PinnedMessage) ProtoMessage() {}
 
-func (x *GetRoomsByCriteriaRequest) ProtoReflect() protoreflect.Message {
+func (x *PinnedMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_roomService_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -490,57 +693,72 @@ func (x *GetRoomsByCriteriaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoomsByCriteriaRequest.ProtoReflect.Descriptor instead.
-func (*GetRoomsByCriteriaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PinnedMessage.ProtoReflect.Descriptor instead.
+func (*PinnedMessage) Descriptor() ([]byte, []int) {
 	return file_roomService_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetRoomsByCriteriaRequest) GetReferenceId() string {
+func (x *PinnedMessage) GetMessageId() *UUID {
 	if x != nil {
-		return x.ReferenceId
+		return x.MessageId
+	}
+	return nil
+}
+
+func (x *PinnedMessage) GetType() string {
+	if x != nil {
+		return x.Type
 	}
 	return ""
 }
 
-func (x *GetRoomsByCriteriaRequest) GetAccountId() *AccountIdRequest {
+func (x *PinnedMessage) GetText() string {
 	if x != nil {
-		return x.AccountId
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PinnedMessage) GetSenderAccountId() *UUID {
+	if x != nil {
+		return x.SenderAccountId
 	}
 	return nil
 }
 
-func (x *GetRoomsByCriteriaRequest) GetRoomId() *UUID {
+func (x *PinnedMessage) GetCreatedAt() *Timestamp {
 	if x != nil {
-		return x.RoomId
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *GetRoomsByCriteriaRequest) GetWithClosed() bool {
+func (x *PinnedMessage) GetPinnedBy() *UUID {
 	if x != nil {
-		return x.WithClosed
+		return x.PinnedBy
 	}
-	return false
+	return nil
 }
 
-func (x *GetRoomsByCriteriaRequest) GetWithSubscribers() bool {
+func (x *PinnedMessage) GetPinnedAt() *Timestamp {
 	if x != nil {
-		return x.WithSubscribers
+		return x.PinnedAt
 	}
-	return false
+	return nil
 }
 
-type GetRoomsByCriteriaResponse struct {
+type PinMessageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rooms  []*GetRoomResponse `protobuf:"bytes,1,rep,name=Rooms,proto3" json:"Rooms,omitempty"`
-	Errors []*Error           `protobuf:"bytes,2,rep,name=Errors,proto3" json:"Errors,omitempty"`
+	RoomId             *UUID `protobuf:"bytes,1,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	MessageId          *UUID `protobuf:"bytes,2,opt,name=MessageId,proto3" json:"MessageId,omitempty"`
+	InitiatorAccountId *UUID `protobuf:"bytes,3,opt,name=InitiatorAccountId,proto3" json:"InitiatorAccountId,omitempty"`
 }
 
-func (x *GetRoomsByCriteriaResponse) Reset() {
-	*x = GetRoomsByCriteriaResponse{}
+func (x *PinMessageRequest) Reset() {
+	*x = PinMessageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roomService_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -548,13 +766,13 @@ func (x *GetRoomsByCriteriaResponse) Reset() {
 	}
 }
 
-func (x *GetRoomsByCriteriaResponse) String() string {
+func (x *PinMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoomsByCriteriaResponse) ProtoMessage() {}
+func (*PinMessageRequest) ProtoMessage() {}
 
-func (x *GetRoomsByCriteriaResponse) ProtoReflect() protoreflect.Message {
+func (x *PinMessageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_roomService_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -566,37 +784,42 @@ func (x *GetRoomsByCriteriaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoomsByCriteriaResponse.ProtoReflect.Descriptor instead.
-func (*GetRoomsByCriteriaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PinMessageRequest.ProtoReflect.Descriptor instead.
+func (*PinMessageRequest) Descriptor() ([]byte, []int) {
 	return file_roomService_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetRoomsByCriteriaResponse) GetRooms() []*GetRoomResponse {
+func (x *PinMessageRequest) GetRoomId() *UUID {
 	if x != nil {
-		return x.Rooms
+		return x.RoomId
 	}
 	return nil
 }
 
-func (x *GetRoomsByCriteriaResponse) GetErrors() []*Error {
+func (x *PinMessageRequest) GetMessageId() *UUID {
 	if x != nil {
-		return x.Errors
+		return x.MessageId
 	}
 	return nil
 }
 
-type RoomSubscribeRequest struct {
+func (x *PinMessageRequest) GetInitiatorAccountId() *UUID {
+	if x != nil {
+		return x.InitiatorAccountId
+	}
+	return nil
+}
+
+type PinMessageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomId      *UUID                `protobuf:"bytes,1,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
-	ReferenceId string               `protobuf:"bytes,2,opt,name=ReferenceId,proto3" json:"ReferenceId,omitempty"`
-	Subscribers []*SubscriberRequest `protobuf:"bytes,3,rep,name=Subscribers,proto3" json:"Subscribers,omitempty"`
+	Errors []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
 }
 
-func (x *RoomSubscribeRequest) Reset() {
-	*x = RoomSubscribeRequest{}
+func (x *PinMessageResponse) Reset() {
+	*x = PinMessageResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roomService_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -604,13 +827,13 @@ func (x *RoomSubscribeRequest) Reset() {
 	}
 }
 
-func (x *RoomSubscribeRequest) String() string {
+func (x *PinMessageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoomSubscribeRequest) ProtoMessage() {}
+func (*PinMessageResponse) ProtoMessage() {}
 
-func (x *RoomSubscribeRequest) ProtoReflect() protoreflect.Message {
+func (x *PinMessageResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_roomService_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -622,43 +845,31 @@ func (x *RoomSubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoomSubscribeRequest.ProtoReflect.Descriptor instead.
-func (*RoomSubscribeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PinMessageResponse.ProtoReflect.Descriptor instead.
+func (*PinMessageResponse) Descriptor() ([]byte, []int) {
 	return file_roomService_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RoomSubscribeRequest) GetRoomId() *UUID {
-	if x != nil {
-		return x.RoomId
-	}
-	return nil
-}
-
-func (x *RoomSubscribeRequest) GetReferenceId() string {
+func (x *PinMessageResponse) GetErrors() []*Error {
 	if x != nil {
-		return x.ReferenceId
-	}
-	return ""
-}
-
-func (x *RoomSubscribeRequest) GetSubscribers() []*SubscriberRequest {
-	if x != nil {
-		return x.Subscribers
+		return x.Errors
 	}
 	return nil
 }
 
-type RoomSubscribeResponse struct {
+type ForwardMessagesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rooms  []*GetRoomResponse `protobuf:"bytes,1,rep,name=Rooms,proto3" json:"Rooms,omitempty"`
-	Errors []*Error           `protobuf:"bytes,2,rep,name=Errors,proto3" json:"Errors,omitempty"`
+	AccountId    *UUID   `protobuf:"bytes,1,opt,name=AccountId,proto3" json:"AccountId,omitempty"`
+	SourceRoomId *UUID   `protobuf:"bytes,2,opt,name=SourceRoomId,proto3" json:"SourceRoomId,omitempty"`
+	TargetRoomId *UUID   `protobuf:"bytes,3,opt,name=TargetRoomId,proto3" json:"TargetRoomId,omitempty"`
+	MessageIds   []*UUID `protobuf:"bytes,4,rep,name=MessageIds,proto3" json:"MessageIds,omitempty"`
 }
 
-func (x *RoomSubscribeResponse) Reset() {
-	*x = RoomSubscribeResponse{}
+func (x *ForwardMessagesRequest) Reset() {
+	*x = ForwardMessagesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roomService_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -666,13 +877,13 @@ func (x *RoomSubscribeResponse) Reset() {
 	}
 }
 
-func (x *RoomSubscribeResponse) String() string {
+func (x *ForwardMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoomSubscribeResponse) ProtoMessage() {}
+func (*ForwardMessagesRequest) ProtoMessage() {}
 
-func (x *RoomSubscribeResponse) ProtoReflect() protoreflect.Message {
+func (x *ForwardMessagesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_roomService_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -684,36 +895,49 @@ func (x *RoomSubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoomSubscribeResponse.ProtoReflect.Descriptor instead.
-func (*RoomSubscribeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForwardMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ForwardMessagesRequest) Descriptor() ([]byte, []int) {
 	return file_roomService_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RoomSubscribeResponse) GetRooms() []*GetRoomResponse {
+func (x *ForwardMessagesRequest) GetAccountId() *UUID {
 	if x != nil {
-		return x.Rooms
+		return x.AccountId
 	}
 	return nil
 }
 
-func (x *RoomSubscribeResponse) GetErrors() []*Error {
+func (x *ForwardMessagesRequest) GetSourceRoomId() *UUID {
 	if x != nil {
-		return x.Errors
+		return x.SourceRoomId
 	}
 	return nil
 }
 
-type CloseRoomRequest struct {
+func (x *ForwardMessagesRequest) GetTargetRoomId() *UUID {
+	if x != nil {
+		return x.TargetRoomId
+	}
+	return nil
+}
+
+func (x *ForwardMessagesRequest) GetMessageIds() []*UUID {
+	if x != nil {
+		return x.MessageIds
+	}
+	return nil
+}
+
+type ForwardMessagesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomId      *UUID  `protobuf:"bytes,1,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
-	ReferenceId string `protobuf:"bytes,2,opt,name=ReferenceId,proto3" json:"ReferenceId,omitempty"`
+	Errors []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
 }
 
-func (x *CloseRoomRequest) Reset() {
-	*x = CloseRoomRequest{}
+func (x *ForwardMessagesResponse) Reset() {
+	*x = ForwardMessagesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roomService_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -721,13 +945,13 @@ func (x *CloseRoomRequest) Reset() {
 	}
 }
 
-func (x *CloseRoomRequest) String() string {
+func (x *ForwardMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseRoomRequest) ProtoMessage() {}
+func (*ForwardMessagesResponse) ProtoMessage() {}
 
-func (x *CloseRoomRequest) ProtoReflect() protoreflect.Message {
+func (x *ForwardMessagesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_roomService_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -739,35 +963,29 @@ func (x *CloseRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseRoomRequest.ProtoReflect.Descriptor instead.
-func (*CloseRoomRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForwardMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ForwardMessagesResponse) Descriptor() ([]byte, []int) {
 	return file_roomService_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CloseRoomRequest) GetRoomId() *UUID {
+func (x *ForwardMessagesResponse) GetErrors() []*Error {
 	if x != nil {
-		return x.RoomId
+		return x.Errors
 	}
 	return nil
 }
 
-func (x *CloseRoomRequest) GetReferenceId() string {
-	if x != nil {
-		return x.ReferenceId
-	}
-	return ""
-}
-
-type CloseRoomResponse struct {
+type StarMessageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Errors []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
+	AccountId *UUID `protobuf:"bytes,1,opt,name=AccountId,proto3" json:"AccountId,omitempty"`
+	MessageId *UUID `protobuf:"bytes,2,opt,name=MessageId,proto3" json:"MessageId,omitempty"`
 }
 
-func (x *CloseRoomResponse) Reset() {
-	*x = CloseRoomResponse{}
+func (x *StarMessageRequest) Reset() {
+	*x = StarMessageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roomService_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -775,13 +993,13 @@ func (x *CloseRoomResponse) Reset() {
 	}
 }
 
-func (x *CloseRoomResponse) String() string {
+func (x *StarMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseRoomResponse) ProtoMessage() {}
+func (*StarMessageRequest) ProtoMessage() {}
 
-func (x *CloseRoomResponse) ProtoReflect() protoreflect.Message {
+func (x *StarMessageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_roomService_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -793,33 +1011,35 @@ func (x *CloseRoomResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseRoomResponse.ProtoReflect.Descriptor instead.
-func (*CloseRoomResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StarMessageRequest.ProtoReflect.Descriptor instead.
+func (*StarMessageRequest) Descriptor() ([]byte, []int) {
 	return file_roomService_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CloseRoomResponse) GetErrors() []*Error {
+func (x *StarMessageRequest) GetAccountId() *UUID {
 	if x != nil {
-		return x.Errors
+		return x.AccountId
 	}
 	return nil
 }
 
-type SendChatMessageDataRequest struct {
+func (x *StarMessageRequest) GetMessageId() *UUID {
+	if x != nil {
+		return x.MessageId
+	}
+	return nil
+}
+
+type StarMessageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientMessageId    string            `protobuf:"bytes,1,opt,name=ClientMessageId,proto3" json:"ClientMessageId,omitempty"`
-	RoomId             *UUID             `protobuf:"bytes,2,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
-	Type               string            `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
-	Text               string            `protobuf:"bytes,4,opt,name=Text,proto3" json:"Text,omitempty"`
-	Params             map[string]string `protobuf:"bytes,5,rep,name=Params,proto3" json:"Params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	RecipientAccountId *UUID             `protobuf:"bytes,6,opt,name=RecipientAccountId,proto3" json:"RecipientAccountId,omitempty"`
+	Errors []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
 }
 
-func (x *SendChatMessageDataRequest) Reset() {
-	*x = SendChatMessageDataRequest{}
+func (x *StarMessageResponse) Reset() {
+	*x = StarMessageResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roomService_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -827,13 +1047,13 @@ func (x *SendChatMessageDataRequest) Reset() {
 	}
 }
 
-func (x *SendChatMessageDataRequest) String() string {
+func (x *StarMessageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendChatMessageDataRequest) ProtoMessage() {}
+func (*StarMessageResponse) ProtoMessage() {}
 
-func (x *SendChatMessageDataRequest) ProtoReflect() protoreflect.Message {
+func (x *StarMessageResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_roomService_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -845,63 +1065,34 @@ func (x *SendChatMessageDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendChatMessageDataRequest.ProtoReflect.Descriptor instead.
-func (*SendChatMessageDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StarMessageResponse.ProtoReflect.Descriptor instead.
+func (*StarMessageResponse) Descriptor() ([]byte, []int) {
 	return file_roomService_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SendChatMessageDataRequest) GetClientMessageId() string {
-	if x != nil {
-		return x.ClientMessageId
-	}
-	return ""
-}
-
-func (x *SendChatMessageDataRequest) GetRoomId() *UUID {
-	if x != nil {
-		return x.RoomId
-	}
-	return nil
-}
-
-func (x *SendChatMessageDataRequest) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *SendChatMessageDataRequest) GetText() string {
-	if x != nil {
-		return x.Text
-	}
-	return ""
-}
-
-func (x *SendChatMessageDataRequest) GetParams() map[string]string {
-	if x != nil {
-		return x.Params
-	}
-	return nil
-}
-
-func (x *SendChatMessageDataRequest) GetRecipientAccountId() *UUID {
+func (x *StarMessageResponse) GetErrors() []*Error {
 	if x != nil {
-		return x.RecipientAccountId
+		return x.Errors
 	}
 	return nil
 }
 
-type SendChatMessagesDataRequest struct {
+type GetRoomsByCriteriaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Messages []*SendChatMessageDataRequest `protobuf:"bytes,1,rep,name=Messages,proto3" json:"Messages,omitempty"`
+	ReferenceId     string            `protobuf:"bytes,1,opt,name=ReferenceId,proto3" json:"ReferenceId,omitempty"`
+	AccountId       *AccountIdRequest `protobuf:"bytes,2,opt,name=AccountId,proto3" json:"AccountId,omitempty"`
+	RoomId          *UUID             `protobuf:"bytes,3,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	WithClosed      bool              `protobuf:"varint,4,opt,name=WithClosed,proto3" json:"WithClosed,omitempty"`
+	WithSubscribers bool              `protobuf:"varint,5,opt,name=WithSubscribers,proto3" json:"WithSubscribers,omitempty"`
+	WithArchived    bool              `protobuf:"varint,6,opt,name=WithArchived,proto3" json:"WithArchived,omitempty"`
+	Tags            []string          `protobuf:"bytes,7,rep,name=Tags,proto3" json:"Tags,omitempty"`
 }
 
-func (x *SendChatMessagesDataRequest) Reset() {
-	*x = SendChatMessagesDataRequest{}
+func (x *GetRoomsByCriteriaRequest) Reset() {
+	*x = GetRoomsByCriteriaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roomService_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -909,13 +1100,13 @@ func (x *SendChatMessagesDataRequest) Reset() {
 	}
 }
 
-func (x *SendChatMessagesDataRequest) String() string {
+func (x *GetRoomsByCriteriaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendChatMessagesDataRequest) ProtoMessage() {}
+func (*GetRoomsByCriteriaRequest) ProtoMessage() {}
 
-func (x *SendChatMessagesDataRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRoomsByCriteriaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_roomService_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -927,30 +1118,71 @@ func (x *SendChatMessagesDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendChatMessagesDataRequest.ProtoReflect.Descriptor instead.
-func (*SendChatMessagesDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRoomsByCriteriaRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomsByCriteriaRequest) Descriptor() ([]byte, []int) {
 	return file_roomService_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SendChatMessagesDataRequest) GetMessages() []*SendChatMessageDataRequest {
+func (x *GetRoomsByCriteriaRequest) GetReferenceId() string {
 	if x != nil {
-		return x.Messages
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *GetRoomsByCriteriaRequest) GetAccountId() *AccountIdRequest {
+	if x != nil {
+		return x.AccountId
 	}
 	return nil
 }
 
-type SendChatMessagesRequest struct {
+func (x *GetRoomsByCriteriaRequest) GetRoomId() *UUID {
+	if x != nil {
+		return x.RoomId
+	}
+	return nil
+}
+
+func (x *GetRoomsByCriteriaRequest) GetWithClosed() bool {
+	if x != nil {
+		return x.WithClosed
+	}
+	return false
+}
+
+func (x *GetRoomsByCriteriaRequest) GetWithSubscribers() bool {
+	if x != nil {
+		return x.WithSubscribers
+	}
+	return false
+}
+
+func (x *GetRoomsByCriteriaRequest) GetWithArchived() bool {
+	if x != nil {
+		return x.WithArchived
+	}
+	return false
+}
+
+func (x *GetRoomsByCriteriaRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type GetRoomsByCriteriaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SenderAccountId *UUID                        `protobuf:"bytes,1,opt,name=SenderAccountId,proto3" json:"SenderAccountId,omitempty"`
-	Type            string                       `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
-	Data            *SendChatMessagesDataRequest `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	Rooms  []*GetRoomResponse `protobuf:"bytes,1,rep,name=Rooms,proto3" json:"Rooms,omitempty"`
+	Errors []*Error           `protobuf:"bytes,2,rep,name=Errors,proto3" json:"Errors,omitempty"`
 }
 
-func (x *SendChatMessagesRequest) Reset() {
-	*x = SendChatMessagesRequest{}
+func (x *GetRoomsByCriteriaResponse) Reset() {
+	*x = GetRoomsByCriteriaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roomService_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -958,13 +1190,13 @@ func (x *SendChatMessagesRequest) Reset() {
 	}
 }
 
-func (x *SendChatMessagesRequest) String() string {
+func (x *GetRoomsByCriteriaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendChatMessagesRequest) ProtoMessage() {}
+func (*GetRoomsByCriteriaResponse) ProtoMessage() {}
 
-func (x *SendChatMessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRoomsByCriteriaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_roomService_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -976,42 +1208,38 @@ func (x *SendChatMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendChatMessagesRequest.ProtoReflect.Descriptor instead.
-func (*SendChatMessagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRoomsByCriteriaResponse.ProtoReflect.Descriptor instead.
+func (*GetRoomsByCriteriaResponse) Descriptor() ([]byte, []int) {
 	return file_roomService_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *SendChatMessagesRequest) GetSenderAccountId() *UUID {
+func (x *GetRoomsByCriteriaResponse) GetRooms() []*GetRoomResponse {
 	if x != nil {
-		return x.SenderAccountId
+		return x.Rooms
 	}
 	return nil
 }
 
-func (x *SendChatMessagesRequest) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *SendChatMessagesRequest) GetData() *SendChatMessagesDataRequest {
+func (x *GetRoomsByCriteriaResponse) GetErrors() []*Error {
 	if x != nil {
-		return x.Data
+		return x.Errors
 	}
 	return nil
 }
 
-type SendChatMessageResponse struct {
+type GetAccountRoomsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Errors []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
+	AccountId  *AccountIdRequest `protobuf:"bytes,1,opt,name=AccountId,proto3" json:"AccountId,omitempty"`
+	WithClosed bool              `protobuf:"varint,2,opt,name=WithClosed,proto3" json:"WithClosed,omitempty"`
+	Limit      int32             `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Cursor     string            `protobuf:"bytes,4,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
 }
 
-func (x *SendChatMessageResponse) Reset() {
-	*x = SendChatMessageResponse{}
+func (x *GetAccountRoomsRequest) Reset() {
+	*x = GetAccountRoomsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roomService_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1019,13 +1247,13 @@ func (x *SendChatMessageResponse) Reset() {
 	}
 }
 
-func (x *SendChatMessageResponse) String() string {
+func (x *GetAccountRoomsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendChatMessageResponse) ProtoMessage() {}
+func (*GetAccountRoomsRequest) ProtoMessage() {}
 
-func (x *SendChatMessageResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAccountRoomsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_roomService_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1037,30 +1265,55 @@ func (x *SendChatMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendChatMessageResponse.ProtoReflect.Descriptor instead.
-func (*SendChatMessageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAccountRoomsRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRoomsRequest) Descriptor() ([]byte, []int) {
 	return file_roomService_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *SendChatMessageResponse) GetErrors() []*Error {
+func (x *GetAccountRoomsRequest) GetAccountId() *AccountIdRequest {
 	if x != nil {
-		return x.Errors
+		return x.AccountId
 	}
 	return nil
 }
 
-type RoomUnsubscribeRequest struct {
+func (x *GetAccountRoomsRequest) GetWithClosed() bool {
+	if x != nil {
+		return x.WithClosed
+	}
+	return false
+}
+
+func (x *GetAccountRoomsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAccountRoomsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type AccountRoomLastMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomId      *UUID             `protobuf:"bytes,1,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
-	ReferenceId string            `protobuf:"bytes,2,opt,name=ReferenceId,proto3" json:"ReferenceId,omitempty"`
-	AccountId   *AccountIdRequest `protobuf:"bytes,3,opt,name=AccountId,proto3" json:"AccountId,omitempty"`
+	Id              *UUID      `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Type            string     `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Text            string     `protobuf:"bytes,3,opt,name=Text,proto3" json:"Text,omitempty"`
+	SenderAccountId *UUID      `protobuf:"bytes,4,opt,name=SenderAccountId,proto3" json:"SenderAccountId,omitempty"`
+	CreatedAt       *Timestamp `protobuf:"bytes,5,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	Expired         bool       `protobuf:"varint,6,opt,name=Expired,proto3" json:"Expired,omitempty"`
+	Deleted         bool       `protobuf:"varint,7,opt,name=Deleted,proto3" json:"Deleted,omitempty"`
 }
 
-func (x *RoomUnsubscribeRequest) Reset() {
-	*x = RoomUnsubscribeRequest{}
+func (x *AccountRoomLastMessage) Reset() {
+	*x = AccountRoomLastMessage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roomService_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1068,13 +1321,13 @@ func (x *RoomUnsubscribeRequest) Reset() {
 	}
 }
 
-func (x *RoomUnsubscribeRequest) String() string {
+func (x *AccountRoomLastMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoomUnsubscribeRequest) ProtoMessage() {}
+func (*AccountRoomLastMessage) ProtoMessage() {}
 
-func (x *RoomUnsubscribeRequest) ProtoReflect() protoreflect.Message {
+func (x *AccountRoomLastMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_roomService_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1086,42 +1339,79 @@ func (x *RoomUnsubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoomUnsubscribeRequest.ProtoReflect.Descriptor instead.
-func (*RoomUnsubscribeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountRoomLastMessage.ProtoReflect.Descriptor instead.
+func (*AccountRoomLastMessage) Descriptor() ([]byte, []int) {
 	return file_roomService_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *RoomUnsubscribeRequest) GetRoomId() *UUID {
+func (x *AccountRoomLastMessage) GetId() *UUID {
 	if x != nil {
-		return x.RoomId
+		return x.Id
 	}
 	return nil
 }
 
-func (x *RoomUnsubscribeRequest) GetReferenceId() string {
+func (x *AccountRoomLastMessage) GetType() string {
 	if x != nil {
-		return x.ReferenceId
+		return x.Type
 	}
 	return ""
 }
 
-func (x *RoomUnsubscribeRequest) GetAccountId() *AccountIdRequest {
+func (x *AccountRoomLastMessage) GetText() string {
 	if x != nil {
-		return x.AccountId
+		return x.Text
+	}
+	return ""
+}
+
+func (x *AccountRoomLastMessage) GetSenderAccountId() *UUID {
+	if x != nil {
+		return x.SenderAccountId
 	}
 	return nil
 }
 
-type RoomUnsubscribeResponse struct {
+func (x *AccountRoomLastMessage) GetCreatedAt() *Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AccountRoomLastMessage) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *AccountRoomLastMessage) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type AccountRoom struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Errors []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
+	Id             *UUID                    `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ReferenceId    string                   `protobuf:"bytes,2,opt,name=ReferenceId,proto3" json:"ReferenceId,omitempty"`
+	Type           string                   `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	Title          string                   `protobuf:"bytes,4,opt,name=Title,proto3" json:"Title,omitempty"`
+	AvatarUrl      string                   `protobuf:"bytes,5,opt,name=AvatarUrl,proto3" json:"AvatarUrl,omitempty"`
+	ClosedAt       *Timestamp               `protobuf:"bytes,6,opt,name=ClosedAt,proto3" json:"ClosedAt,omitempty"`
+	LastActivityAt *Timestamp               `protobuf:"bytes,7,opt,name=LastActivityAt,proto3" json:"LastActivityAt,omitempty"`
+	LastMessage    *AccountRoomLastMessage  `protobuf:"bytes,8,opt,name=LastMessage,proto3" json:"LastMessage,omitempty"`
+	UnreadCount    int64                    `protobuf:"varint,9,opt,name=UnreadCount,proto3" json:"UnreadCount,omitempty"`
+	Subscribers    []*GetSubscriberResponse `protobuf:"bytes,10,rep,name=Subscribers,proto3" json:"Subscribers,omitempty"`
 }
 
-func (x *RoomUnsubscribeResponse) Reset() {
-	*x = RoomUnsubscribeResponse{}
+func (x *AccountRoom) Reset() {
+	*x = AccountRoom{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roomService_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1129,13 +1419,13 @@ func (x *RoomUnsubscribeResponse) Reset() {
 	}
 }
 
-func (x *RoomUnsubscribeResponse) String() string {
+func (x *AccountRoom) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoomUnsubscribeResponse) ProtoMessage() {}
+func (*AccountRoom) ProtoMessage() {}
 
-func (x *RoomUnsubscribeResponse) ProtoReflect() protoreflect.Message {
+func (x *AccountRoom) ProtoReflect() protoreflect.Message {
 	mi := &file_roomService_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1147,37 +1437,2689 @@ func (x *RoomUnsubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoomUnsubscribeResponse.ProtoReflect.Descriptor instead.
-func (*RoomUnsubscribeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountRoom.ProtoReflect.Descriptor instead.
+func (*AccountRoom) Descriptor() ([]byte, []int) {
 	return file_roomService_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *RoomUnsubscribeResponse) GetErrors() []*Error {
+func (x *AccountRoom) GetId() *UUID {
 	if x != nil {
-		return x.Errors
+		return x.Id
 	}
 	return nil
 }
 
-var File_roomService_proto protoreflect.FileDescriptor
+func (x *AccountRoom) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
 
-var file_roomService_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x72, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
-	0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+func (x *AccountRoom) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AccountRoom) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AccountRoom) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *AccountRoom) GetClosedAt() *Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *AccountRoom) GetLastActivityAt() *Timestamp {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return nil
+}
+
+func (x *AccountRoom) GetLastMessage() *AccountRoomLastMessage {
+	if x != nil {
+		return x.LastMessage
+	}
+	return nil
+}
+
+func (x *AccountRoom) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *AccountRoom) GetSubscribers() []*GetSubscriberResponse {
+	if x != nil {
+		return x.Subscribers
+	}
+	return nil
+}
+
+type GetAccountRoomsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rooms      []*AccountRoom `protobuf:"bytes,1,rep,name=Rooms,proto3" json:"Rooms,omitempty"`
+	NextCursor string         `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	Errors     []*Error       `protobuf:"bytes,3,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *GetAccountRoomsResponse) Reset() {
+	*x = GetAccountRoomsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountRoomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRoomsResponse) ProtoMessage() {}
+
+func (x *GetAccountRoomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRoomsResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountRoomsResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetAccountRoomsResponse) GetRooms() []*AccountRoom {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *GetAccountRoomsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetAccountRoomsResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type RoomSubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId             *UUID                `protobuf:"bytes,1,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	ReferenceId        string               `protobuf:"bytes,2,opt,name=ReferenceId,proto3" json:"ReferenceId,omitempty"`
+	Subscribers        []*SubscriberRequest `protobuf:"bytes,3,rep,name=Subscribers,proto3" json:"Subscribers,omitempty"`
+	InitiatorAccountId *UUID                `protobuf:"bytes,4,opt,name=InitiatorAccountId,proto3" json:"InitiatorAccountId,omitempty"`
+}
+
+func (x *RoomSubscribeRequest) Reset() {
+	*x = RoomSubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomSubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomSubscribeRequest) ProtoMessage() {}
+
+func (x *RoomSubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*RoomSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RoomSubscribeRequest) GetRoomId() *UUID {
+	if x != nil {
+		return x.RoomId
+	}
+	return nil
+}
+
+func (x *RoomSubscribeRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *RoomSubscribeRequest) GetSubscribers() []*SubscriberRequest {
+	if x != nil {
+		return x.Subscribers
+	}
+	return nil
+}
+
+func (x *RoomSubscribeRequest) GetInitiatorAccountId() *UUID {
+	if x != nil {
+		return x.InitiatorAccountId
+	}
+	return nil
+}
+
+type RoomSubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rooms  []*GetRoomResponse `protobuf:"bytes,1,rep,name=Rooms,proto3" json:"Rooms,omitempty"`
+	Errors []*Error           `protobuf:"bytes,2,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *RoomSubscribeResponse) Reset() {
+	*x = RoomSubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomSubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomSubscribeResponse) ProtoMessage() {}
+
+func (x *RoomSubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*RoomSubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RoomSubscribeResponse) GetRooms() []*GetRoomResponse {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *RoomSubscribeResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type CloseRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId             *UUID  `protobuf:"bytes,1,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	ReferenceId        string `protobuf:"bytes,2,opt,name=ReferenceId,proto3" json:"ReferenceId,omitempty"`
+	InitiatorAccountId *UUID  `protobuf:"bytes,3,opt,name=InitiatorAccountId,proto3" json:"InitiatorAccountId,omitempty"`
+}
+
+func (x *CloseRoomRequest) Reset() {
+	*x = CloseRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseRoomRequest) ProtoMessage() {}
+
+func (x *CloseRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseRoomRequest.ProtoReflect.Descriptor instead.
+func (*CloseRoomRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CloseRoomRequest) GetRoomId() *UUID {
+	if x != nil {
+		return x.RoomId
+	}
+	return nil
+}
+
+func (x *CloseRoomRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *CloseRoomRequest) GetInitiatorAccountId() *UUID {
+	if x != nil {
+		return x.InitiatorAccountId
+	}
+	return nil
+}
+
+type CloseRoomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *CloseRoomResponse) Reset() {
+	*x = CloseRoomResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseRoomResponse) ProtoMessage() {}
+
+func (x *CloseRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseRoomResponse.ProtoReflect.Descriptor instead.
+func (*CloseRoomResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CloseRoomResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// omitted fields aren't changed
+type UpdateRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId      *UUID        `protobuf:"bytes,1,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	ReferenceId string       `protobuf:"bytes,2,opt,name=ReferenceId,proto3" json:"ReferenceId,omitempty"`
+	Title       *StringValue `protobuf:"bytes,3,opt,name=Title,proto3" json:"Title,omitempty"`
+	Description *StringValue `protobuf:"bytes,4,opt,name=Description,proto3" json:"Description,omitempty"`
+	AvatarUrl   *StringValue `protobuf:"bytes,5,opt,name=AvatarUrl,proto3" json:"AvatarUrl,omitempty"`
+	// JSON object, empty value clears the attributes
+	Attributes         *StringValue `protobuf:"bytes,6,opt,name=Attributes,proto3" json:"Attributes,omitempty"`
+	Tags               *StringList  `protobuf:"bytes,7,opt,name=Tags,proto3" json:"Tags,omitempty"`
+	InitiatorAccountId *UUID        `protobuf:"bytes,8,opt,name=InitiatorAccountId,proto3" json:"InitiatorAccountId,omitempty"`
+}
+
+func (x *UpdateRoomRequest) Reset() {
+	*x = UpdateRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomRequest) ProtoMessage() {}
+
+func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateRoomRequest) GetRoomId() *UUID {
+	if x != nil {
+		return x.RoomId
+	}
+	return nil
+}
+
+func (x *UpdateRoomRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetTitle() *StringValue {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *UpdateRoomRequest) GetDescription() *StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *UpdateRoomRequest) GetAvatarUrl() *StringValue {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return nil
+}
+
+func (x *UpdateRoomRequest) GetAttributes() *StringValue {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateRoomRequest) GetTags() *StringList {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateRoomRequest) GetInitiatorAccountId() *UUID {
+	if x != nil {
+		return x.InitiatorAccountId
+	}
+	return nil
+}
+
+type UpdateRoomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *UpdateRoomResponse) Reset() {
+	*x = UpdateRoomResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomResponse) ProtoMessage() {}
+
+func (x *UpdateRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRoomResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateRoomResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ReopenRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId             *UUID  `protobuf:"bytes,1,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	ReferenceId        string `protobuf:"bytes,2,opt,name=ReferenceId,proto3" json:"ReferenceId,omitempty"`
+	InitiatorAccountId *UUID  `protobuf:"bytes,3,opt,name=InitiatorAccountId,proto3" json:"InitiatorAccountId,omitempty"`
+}
+
+func (x *ReopenRoomRequest) Reset() {
+	*x = ReopenRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReopenRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenRoomRequest) ProtoMessage() {}
+
+func (x *ReopenRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenRoomRequest.ProtoReflect.Descriptor instead.
+func (*ReopenRoomRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReopenRoomRequest) GetRoomId() *UUID {
+	if x != nil {
+		return x.RoomId
+	}
+	return nil
+}
+
+func (x *ReopenRoomRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *ReopenRoomRequest) GetInitiatorAccountId() *UUID {
+	if x != nil {
+		return x.InitiatorAccountId
+	}
+	return nil
+}
+
+type ReopenRoomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *ReopenRoomResponse) Reset() {
+	*x = ReopenRoomResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReopenRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenRoomResponse) ProtoMessage() {}
+
+func (x *ReopenRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenRoomResponse.ProtoReflect.Descriptor instead.
+func (*ReopenRoomResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReopenRoomResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ArchiveRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId             *UUID  `protobuf:"bytes,1,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	ReferenceId        string `protobuf:"bytes,2,opt,name=ReferenceId,proto3" json:"ReferenceId,omitempty"`
+	InitiatorAccountId *UUID  `protobuf:"bytes,3,opt,name=InitiatorAccountId,proto3" json:"InitiatorAccountId,omitempty"`
+}
+
+func (x *ArchiveRoomRequest) Reset() {
+	*x = ArchiveRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveRoomRequest) ProtoMessage() {}
+
+func (x *ArchiveRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveRoomRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveRoomRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ArchiveRoomRequest) GetRoomId() *UUID {
+	if x != nil {
+		return x.RoomId
+	}
+	return nil
+}
+
+func (x *ArchiveRoomRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *ArchiveRoomRequest) GetInitiatorAccountId() *UUID {
+	if x != nil {
+		return x.InitiatorAccountId
+	}
+	return nil
+}
+
+type ArchiveRoomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *ArchiveRoomResponse) Reset() {
+	*x = ArchiveRoomResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveRoomResponse) ProtoMessage() {}
+
+func (x *ArchiveRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveRoomResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveRoomResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ArchiveRoomResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type SendChatMessageDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientMessageId    string            `protobuf:"bytes,1,opt,name=ClientMessageId,proto3" json:"ClientMessageId,omitempty"`
+	RoomId             *UUID             `protobuf:"bytes,2,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	Type               string            `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	Text               string            `protobuf:"bytes,4,opt,name=Text,proto3" json:"Text,omitempty"`
+	Params             map[string]string `protobuf:"bytes,5,rep,name=Params,proto3" json:"Params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RecipientAccountId *UUID             `protobuf:"bytes,6,opt,name=RecipientAccountId,proto3" json:"RecipientAccountId,omitempty"`
+	Payload            string            `protobuf:"bytes,7,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	Visibility         string            `protobuf:"bytes,8,opt,name=Visibility,proto3" json:"Visibility,omitempty"`
+	VisibleRoles       []string          `protobuf:"bytes,9,rep,name=VisibleRoles,proto3" json:"VisibleRoles,omitempty"`
+	SendAt             *Timestamp        `protobuf:"bytes,10,opt,name=SendAt,proto3" json:"SendAt,omitempty"`
+	Ttl                int64             `protobuf:"varint,11,opt,name=Ttl,proto3" json:"Ttl,omitempty"`
+}
+
+func (x *SendChatMessageDataRequest) Reset() {
+	*x = SendChatMessageDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChatMessageDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessageDataRequest) ProtoMessage() {}
+
+func (x *SendChatMessageDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessageDataRequest.ProtoReflect.Descriptor instead.
+func (*SendChatMessageDataRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SendChatMessageDataRequest) GetClientMessageId() string {
+	if x != nil {
+		return x.ClientMessageId
+	}
+	return ""
+}
+
+func (x *SendChatMessageDataRequest) GetRoomId() *UUID {
+	if x != nil {
+		return x.RoomId
+	}
+	return nil
+}
+
+func (x *SendChatMessageDataRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SendChatMessageDataRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SendChatMessageDataRequest) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *SendChatMessageDataRequest) GetRecipientAccountId() *UUID {
+	if x != nil {
+		return x.RecipientAccountId
+	}
+	return nil
+}
+
+func (x *SendChatMessageDataRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *SendChatMessageDataRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *SendChatMessageDataRequest) GetVisibleRoles() []string {
+	if x != nil {
+		return x.VisibleRoles
+	}
+	return nil
+}
+
+func (x *SendChatMessageDataRequest) GetSendAt() *Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+func (x *SendChatMessageDataRequest) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type SendChatMessagesDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*SendChatMessageDataRequest `protobuf:"bytes,1,rep,name=Messages,proto3" json:"Messages,omitempty"`
+}
+
+func (x *SendChatMessagesDataRequest) Reset() {
+	*x = SendChatMessagesDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChatMessagesDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessagesDataRequest) ProtoMessage() {}
+
+func (x *SendChatMessagesDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessagesDataRequest.ProtoReflect.Descriptor instead.
+func (*SendChatMessagesDataRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SendChatMessagesDataRequest) GetMessages() []*SendChatMessageDataRequest {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type SendChatMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderAccountId *UUID                        `protobuf:"bytes,1,opt,name=SenderAccountId,proto3" json:"SenderAccountId,omitempty"`
+	Type            string                       `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Data            *SendChatMessagesDataRequest `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (x *SendChatMessagesRequest) Reset() {
+	*x = SendChatMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChatMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessagesRequest) ProtoMessage() {}
+
+func (x *SendChatMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SendChatMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SendChatMessagesRequest) GetSenderAccountId() *UUID {
+	if x != nil {
+		return x.SenderAccountId
+	}
+	return nil
+}
+
+func (x *SendChatMessagesRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SendChatMessagesRequest) GetData() *SendChatMessagesDataRequest {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SendChatMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors              []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
+	ScheduledMessageIds []*UUID  `protobuf:"bytes,2,rep,name=ScheduledMessageIds,proto3" json:"ScheduledMessageIds,omitempty"`
+	HeldMessageIds      []*UUID  `protobuf:"bytes,3,rep,name=HeldMessageIds,proto3" json:"HeldMessageIds,omitempty"`
+}
+
+func (x *SendChatMessageResponse) Reset() {
+	*x = SendChatMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChatMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessageResponse) ProtoMessage() {}
+
+func (x *SendChatMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendChatMessageResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SendChatMessageResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *SendChatMessageResponse) GetScheduledMessageIds() []*UUID {
+	if x != nil {
+		return x.ScheduledMessageIds
+	}
+	return nil
+}
+
+func (x *SendChatMessageResponse) GetHeldMessageIds() []*UUID {
+	if x != nil {
+		return x.HeldMessageIds
+	}
+	return nil
+}
+
+type ScheduledMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        *UUID                       `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	RoomId    *UUID                       `protobuf:"bytes,2,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	AccountId *UUID                       `protobuf:"bytes,3,opt,name=AccountId,proto3" json:"AccountId,omitempty"`
+	SendAt    *Timestamp                  `protobuf:"bytes,4,opt,name=SendAt,proto3" json:"SendAt,omitempty"`
+	Message   *SendChatMessageDataRequest `protobuf:"bytes,5,opt,name=Message,proto3" json:"Message,omitempty"`
+}
+
+func (x *ScheduledMessage) Reset() {
+	*x = ScheduledMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledMessage) ProtoMessage() {}
+
+func (x *ScheduledMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledMessage.ProtoReflect.Descriptor instead.
+func (*ScheduledMessage) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ScheduledMessage) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ScheduledMessage) GetRoomId() *UUID {
+	if x != nil {
+		return x.RoomId
+	}
+	return nil
+}
+
+func (x *ScheduledMessage) GetAccountId() *UUID {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *ScheduledMessage) GetSendAt() *Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+func (x *ScheduledMessage) GetMessage() *SendChatMessageDataRequest {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type GetScheduledMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId    *UUID `protobuf:"bytes,1,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	AccountId *UUID `protobuf:"bytes,2,opt,name=AccountId,proto3" json:"AccountId,omitempty"`
+}
+
+func (x *GetScheduledMessagesRequest) Reset() {
+	*x = GetScheduledMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledMessagesRequest) ProtoMessage() {}
+
+func (x *GetScheduledMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduledMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetScheduledMessagesRequest) GetRoomId() *UUID {
+	if x != nil {
+		return x.RoomId
+	}
+	return nil
+}
+
+func (x *GetScheduledMessagesRequest) GetAccountId() *UUID {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+type GetScheduledMessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*ScheduledMessage `protobuf:"bytes,1,rep,name=Messages,proto3" json:"Messages,omitempty"`
+	Errors   []*Error            `protobuf:"bytes,2,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *GetScheduledMessagesResponse) Reset() {
+	*x = GetScheduledMessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledMessagesResponse) ProtoMessage() {}
+
+func (x *GetScheduledMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduledMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetScheduledMessagesResponse) GetMessages() []*ScheduledMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *GetScheduledMessagesResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type CancelScheduledMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 *UUID `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	InitiatorAccountId *UUID `protobuf:"bytes,2,opt,name=InitiatorAccountId,proto3" json:"InitiatorAccountId,omitempty"`
+}
+
+func (x *CancelScheduledMessageRequest) Reset() {
+	*x = CancelScheduledMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMessageRequest) ProtoMessage() {}
+
+func (x *CancelScheduledMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMessageRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMessageRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CancelScheduledMessageRequest) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *CancelScheduledMessageRequest) GetInitiatorAccountId() *UUID {
+	if x != nil {
+		return x.InitiatorAccountId
+	}
+	return nil
+}
+
+type CancelScheduledMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *CancelScheduledMessageResponse) Reset() {
+	*x = CancelScheduledMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMessageResponse) ProtoMessage() {}
+
+func (x *CancelScheduledMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMessageResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMessageResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CancelScheduledMessageResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type HeldMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        *UUID                       `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	RoomId    *UUID                       `protobuf:"bytes,2,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	AccountId *UUID                       `protobuf:"bytes,3,opt,name=AccountId,proto3" json:"AccountId,omitempty"`
+	Filter    string                      `protobuf:"bytes,4,opt,name=Filter,proto3" json:"Filter,omitempty"`
+	Reason    string                      `protobuf:"bytes,5,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	CreatedAt *Timestamp                  `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	Message   *SendChatMessageDataRequest `protobuf:"bytes,7,opt,name=Message,proto3" json:"Message,omitempty"`
+}
+
+func (x *HeldMessage) Reset() {
+	*x = HeldMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeldMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeldMessage) ProtoMessage() {}
+
+func (x *HeldMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeldMessage.ProtoReflect.Descriptor instead.
+func (*HeldMessage) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *HeldMessage) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *HeldMessage) GetRoomId() *UUID {
+	if x != nil {
+		return x.RoomId
+	}
+	return nil
+}
+
+func (x *HeldMessage) GetAccountId() *UUID {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *HeldMessage) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *HeldMessage) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *HeldMessage) GetCreatedAt() *Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *HeldMessage) GetMessage() *SendChatMessageDataRequest {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type GetHeldMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId             *UUID `protobuf:"bytes,1,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	InitiatorAccountId *UUID `protobuf:"bytes,2,opt,name=InitiatorAccountId,proto3" json:"InitiatorAccountId,omitempty"`
+}
+
+func (x *GetHeldMessagesRequest) Reset() {
+	*x = GetHeldMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHeldMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeldMessagesRequest) ProtoMessage() {}
+
+func (x *GetHeldMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeldMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetHeldMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetHeldMessagesRequest) GetRoomId() *UUID {
+	if x != nil {
+		return x.RoomId
+	}
+	return nil
+}
+
+func (x *GetHeldMessagesRequest) GetInitiatorAccountId() *UUID {
+	if x != nil {
+		return x.InitiatorAccountId
+	}
+	return nil
+}
+
+type GetHeldMessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*HeldMessage `protobuf:"bytes,1,rep,name=Messages,proto3" json:"Messages,omitempty"`
+	Errors   []*Error       `protobuf:"bytes,2,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *GetHeldMessagesResponse) Reset() {
+	*x = GetHeldMessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHeldMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeldMessagesResponse) ProtoMessage() {}
+
+func (x *GetHeldMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeldMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetHeldMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetHeldMessagesResponse) GetMessages() []*HeldMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *GetHeldMessagesResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ReviewHeldMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 *UUID  `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Approve            bool   `protobuf:"varint,2,opt,name=Approve,proto3" json:"Approve,omitempty"`
+	Reason             string `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	InitiatorAccountId *UUID  `protobuf:"bytes,4,opt,name=InitiatorAccountId,proto3" json:"InitiatorAccountId,omitempty"`
+}
+
+func (x *ReviewHeldMessageRequest) Reset() {
+	*x = ReviewHeldMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewHeldMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewHeldMessageRequest) ProtoMessage() {}
+
+func (x *ReviewHeldMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewHeldMessageRequest.ProtoReflect.Descriptor instead.
+func (*ReviewHeldMessageRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReviewHeldMessageRequest) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ReviewHeldMessageRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewHeldMessageRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReviewHeldMessageRequest) GetInitiatorAccountId() *UUID {
+	if x != nil {
+		return x.InitiatorAccountId
+	}
+	return nil
+}
+
+type ReviewHeldMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *ReviewHeldMessageResponse) Reset() {
+	*x = ReviewHeldMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewHeldMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewHeldMessageResponse) ProtoMessage() {}
+
+func (x *ReviewHeldMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewHeldMessageResponse.ProtoReflect.Descriptor instead.
+func (*ReviewHeldMessageResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReviewHeldMessageResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ReportMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId *UUID  `protobuf:"bytes,1,opt,name=AccountId,proto3" json:"AccountId,omitempty"`
+	MessageId *UUID  `protobuf:"bytes,2,opt,name=MessageId,proto3" json:"MessageId,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+}
+
+func (x *ReportMessageRequest) Reset() {
+	*x = ReportMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportMessageRequest) ProtoMessage() {}
+
+func (x *ReportMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportMessageRequest.ProtoReflect.Descriptor instead.
+func (*ReportMessageRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReportMessageRequest) GetAccountId() *UUID {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *ReportMessageRequest) GetMessageId() *UUID {
+	if x != nil {
+		return x.MessageId
+	}
+	return nil
+}
+
+func (x *ReportMessageRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReportMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportId *UUID    `protobuf:"bytes,1,opt,name=ReportId,proto3" json:"ReportId,omitempty"`
+	Errors   []*Error `protobuf:"bytes,2,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *ReportMessageResponse) Reset() {
+	*x = ReportMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportMessageResponse) ProtoMessage() {}
+
+func (x *ReportMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportMessageResponse.ProtoReflect.Descriptor instead.
+func (*ReportMessageResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReportMessageResponse) GetReportId() *UUID {
+	if x != nil {
+		return x.ReportId
+	}
+	return nil
+}
+
+func (x *ReportMessageResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type MessageReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        *UUID      `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	AccountId *UUID      `protobuf:"bytes,2,opt,name=AccountId,proto3" json:"AccountId,omitempty"`
+	Reason    string     `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	CreatedAt *Timestamp `protobuf:"bytes,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+}
+
+func (x *MessageReport) Reset() {
+	*x = MessageReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReport) ProtoMessage() {}
+
+func (x *MessageReport) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReport.ProtoReflect.Descriptor instead.
+func (*MessageReport) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MessageReport) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *MessageReport) GetAccountId() *UUID {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *MessageReport) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MessageReport) GetCreatedAt() *Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ReportedMessageItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 *UUID      `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Type               string     `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Text               string     `protobuf:"bytes,3,opt,name=Text,proto3" json:"Text,omitempty"`
+	FileId             string     `protobuf:"bytes,4,opt,name=FileId,proto3" json:"FileId,omitempty"`
+	SenderAccountId    *UUID      `protobuf:"bytes,5,opt,name=SenderAccountId,proto3" json:"SenderAccountId,omitempty"`
+	RecipientAccountId *UUID      `protobuf:"bytes,6,opt,name=RecipientAccountId,proto3" json:"RecipientAccountId,omitempty"`
+	Expired            bool       `protobuf:"varint,7,opt,name=Expired,proto3" json:"Expired,omitempty"`
+	CreatedAt          *Timestamp `protobuf:"bytes,8,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	Deleted            bool       `protobuf:"varint,9,opt,name=Deleted,proto3" json:"Deleted,omitempty"`
+}
+
+func (x *ReportedMessageItem) Reset() {
+	*x = ReportedMessageItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportedMessageItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportedMessageItem) ProtoMessage() {}
+
+func (x *ReportedMessageItem) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportedMessageItem.ProtoReflect.Descriptor instead.
+func (*ReportedMessageItem) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReportedMessageItem) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ReportedMessageItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ReportedMessageItem) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ReportedMessageItem) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *ReportedMessageItem) GetSenderAccountId() *UUID {
+	if x != nil {
+		return x.SenderAccountId
+	}
+	return nil
+}
+
+func (x *ReportedMessageItem) GetRecipientAccountId() *UUID {
+	if x != nil {
+		return x.RecipientAccountId
+	}
+	return nil
+}
+
+func (x *ReportedMessageItem) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *ReportedMessageItem) GetCreatedAt() *Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReportedMessageItem) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type ReportedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId  *UUID                  `protobuf:"bytes,1,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	Message *ReportedMessageItem   `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	Reports []*MessageReport       `protobuf:"bytes,3,rep,name=Reports,proto3" json:"Reports,omitempty"`
+	Context []*ReportedMessageItem `protobuf:"bytes,4,rep,name=Context,proto3" json:"Context,omitempty"`
+}
+
+func (x *ReportedMessage) Reset() {
+	*x = ReportedMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportedMessage) ProtoMessage() {}
+
+func (x *ReportedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportedMessage.ProtoReflect.Descriptor instead.
+func (*ReportedMessage) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReportedMessage) GetRoomId() *UUID {
+	if x != nil {
+		return x.RoomId
+	}
+	return nil
+}
+
+func (x *ReportedMessage) GetMessage() *ReportedMessageItem {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ReportedMessage) GetReports() []*MessageReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *ReportedMessage) GetContext() []*ReportedMessageItem {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type GetMessageReportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId             *UUID `protobuf:"bytes,1,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	ContextSize        int32 `protobuf:"varint,2,opt,name=ContextSize,proto3" json:"ContextSize,omitempty"`
+	PageSize           int32 `protobuf:"varint,3,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	PageIndex          int32 `protobuf:"varint,4,opt,name=PageIndex,proto3" json:"PageIndex,omitempty"`
+	InitiatorAccountId *UUID `protobuf:"bytes,5,opt,name=InitiatorAccountId,proto3" json:"InitiatorAccountId,omitempty"`
+}
+
+func (x *GetMessageReportsRequest) Reset() {
+	*x = GetMessageReportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMessageReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageReportsRequest) ProtoMessage() {}
+
+func (x *GetMessageReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageReportsRequest.ProtoReflect.Descriptor instead.
+func (*GetMessageReportsRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetMessageReportsRequest) GetRoomId() *UUID {
+	if x != nil {
+		return x.RoomId
+	}
+	return nil
+}
+
+func (x *GetMessageReportsRequest) GetContextSize() int32 {
+	if x != nil {
+		return x.ContextSize
+	}
+	return 0
+}
+
+func (x *GetMessageReportsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetMessageReportsRequest) GetPageIndex() int32 {
+	if x != nil {
+		return x.PageIndex
+	}
+	return 0
+}
+
+func (x *GetMessageReportsRequest) GetInitiatorAccountId() *UUID {
+	if x != nil {
+		return x.InitiatorAccountId
+	}
+	return nil
+}
+
+type GetMessageReportsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages  []*ReportedMessage `protobuf:"bytes,1,rep,name=Messages,proto3" json:"Messages,omitempty"`
+	Pages     int32              `protobuf:"varint,2,opt,name=Pages,proto3" json:"Pages,omitempty"`
+	PageIndex int32              `protobuf:"varint,3,opt,name=PageIndex,proto3" json:"PageIndex,omitempty"`
+	Errors    []*Error           `protobuf:"bytes,4,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *GetMessageReportsResponse) Reset() {
+	*x = GetMessageReportsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMessageReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageReportsResponse) ProtoMessage() {}
+
+func (x *GetMessageReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageReportsResponse.ProtoReflect.Descriptor instead.
+func (*GetMessageReportsResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetMessageReportsResponse) GetMessages() []*ReportedMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *GetMessageReportsResponse) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *GetMessageReportsResponse) GetPageIndex() int32 {
+	if x != nil {
+		return x.PageIndex
+	}
+	return 0
+}
+
+func (x *GetMessageReportsResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ResolveMessageReportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId *UUID `protobuf:"bytes,1,opt,name=MessageId,proto3" json:"MessageId,omitempty"`
+	// dismiss | delete | lock
+	Resolution         string `protobuf:"bytes,2,opt,name=Resolution,proto3" json:"Resolution,omitempty"`
+	Comment            string `protobuf:"bytes,3,opt,name=Comment,proto3" json:"Comment,omitempty"`
+	InitiatorAccountId *UUID  `protobuf:"bytes,4,opt,name=InitiatorAccountId,proto3" json:"InitiatorAccountId,omitempty"`
+}
+
+func (x *ResolveMessageReportsRequest) Reset() {
+	*x = ResolveMessageReportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveMessageReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMessageReportsRequest) ProtoMessage() {}
+
+func (x *ResolveMessageReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMessageReportsRequest.ProtoReflect.Descriptor instead.
+func (*ResolveMessageReportsRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ResolveMessageReportsRequest) GetMessageId() *UUID {
+	if x != nil {
+		return x.MessageId
+	}
+	return nil
+}
+
+func (x *ResolveMessageReportsRequest) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *ResolveMessageReportsRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ResolveMessageReportsRequest) GetInitiatorAccountId() *UUID {
+	if x != nil {
+		return x.InitiatorAccountId
+	}
+	return nil
+}
+
+type ResolveMessageReportsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *ResolveMessageReportsResponse) Reset() {
+	*x = ResolveMessageReportsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveMessageReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMessageReportsResponse) ProtoMessage() {}
+
+func (x *ResolveMessageReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMessageReportsResponse.ProtoReflect.Descriptor instead.
+func (*ResolveMessageReportsResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ResolveMessageReportsResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type RoomUnsubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId             *UUID             `protobuf:"bytes,1,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	ReferenceId        string            `protobuf:"bytes,2,opt,name=ReferenceId,proto3" json:"ReferenceId,omitempty"`
+	AccountId          *AccountIdRequest `protobuf:"bytes,3,opt,name=AccountId,proto3" json:"AccountId,omitempty"`
+	InitiatorAccountId *UUID             `protobuf:"bytes,4,opt,name=InitiatorAccountId,proto3" json:"InitiatorAccountId,omitempty"`
+}
+
+func (x *RoomUnsubscribeRequest) Reset() {
+	*x = RoomUnsubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomUnsubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomUnsubscribeRequest) ProtoMessage() {}
+
+func (x *RoomUnsubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomUnsubscribeRequest.ProtoReflect.Descriptor instead.
+func (*RoomUnsubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RoomUnsubscribeRequest) GetRoomId() *UUID {
+	if x != nil {
+		return x.RoomId
+	}
+	return nil
+}
+
+func (x *RoomUnsubscribeRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *RoomUnsubscribeRequest) GetAccountId() *AccountIdRequest {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *RoomUnsubscribeRequest) GetInitiatorAccountId() *UUID {
+	if x != nil {
+		return x.InitiatorAccountId
+	}
+	return nil
+}
+
+type RoomUnsubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *RoomUnsubscribeResponse) Reset() {
+	*x = RoomUnsubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomUnsubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomUnsubscribeResponse) ProtoMessage() {}
+
+func (x *RoomUnsubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomUnsubscribeResponse.ProtoReflect.Descriptor instead.
+func (*RoomUnsubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RoomUnsubscribeResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type TransferRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId             *UUID             `protobuf:"bytes,1,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	FromAccount        *AccountIdRequest `protobuf:"bytes,2,opt,name=FromAccount,proto3" json:"FromAccount,omitempty"`
+	ToAccount          *AccountIdRequest `protobuf:"bytes,3,opt,name=ToAccount,proto3" json:"ToAccount,omitempty"`
+	Reason             string            `protobuf:"bytes,4,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	InitiatorAccountId *UUID             `protobuf:"bytes,5,opt,name=InitiatorAccountId,proto3" json:"InitiatorAccountId,omitempty"`
+}
+
+func (x *TransferRoomRequest) Reset() {
+	*x = TransferRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferRoomRequest) ProtoMessage() {}
+
+func (x *TransferRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferRoomRequest.ProtoReflect.Descriptor instead.
+func (*TransferRoomRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TransferRoomRequest) GetRoomId() *UUID {
+	if x != nil {
+		return x.RoomId
+	}
+	return nil
+}
+
+func (x *TransferRoomRequest) GetFromAccount() *AccountIdRequest {
+	if x != nil {
+		return x.FromAccount
+	}
+	return nil
+}
+
+func (x *TransferRoomRequest) GetToAccount() *AccountIdRequest {
+	if x != nil {
+		return x.ToAccount
+	}
+	return nil
+}
+
+func (x *TransferRoomRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TransferRoomRequest) GetInitiatorAccountId() *UUID {
+	if x != nil {
+		return x.InitiatorAccountId
+	}
+	return nil
+}
+
+type TransferRoomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *TransferRoomResponse) Reset() {
+	*x = TransferRoomResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferRoomResponse) ProtoMessage() {}
+
+func (x *TransferRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferRoomResponse.ProtoReflect.Descriptor instead.
+func (*TransferRoomResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TransferRoomResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type PromoteObserverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId             *UUID             `protobuf:"bytes,1,opt,name=RoomId,proto3" json:"RoomId,omitempty"`
+	Account            *AccountIdRequest `protobuf:"bytes,2,opt,name=Account,proto3" json:"Account,omitempty"`
+	Role               string            `protobuf:"bytes,3,opt,name=Role,proto3" json:"Role,omitempty"`
+	InitiatorAccountId *UUID             `protobuf:"bytes,4,opt,name=InitiatorAccountId,proto3" json:"InitiatorAccountId,omitempty"`
+}
+
+func (x *PromoteObserverRequest) Reset() {
+	*x = PromoteObserverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteObserverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteObserverRequest) ProtoMessage() {}
+
+func (x *PromoteObserverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteObserverRequest.ProtoReflect.Descriptor instead.
+func (*PromoteObserverRequest) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PromoteObserverRequest) GetRoomId() *UUID {
+	if x != nil {
+		return x.RoomId
+	}
+	return nil
+}
+
+func (x *PromoteObserverRequest) GetAccount() *AccountIdRequest {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *PromoteObserverRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PromoteObserverRequest) GetInitiatorAccountId() *UUID {
+	if x != nil {
+		return x.InitiatorAccountId
+	}
+	return nil
+}
+
+type PromoteObserverResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []*Error `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *PromoteObserverResponse) Reset() {
+	*x = PromoteObserverResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roomService_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteObserverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteObserverResponse) ProtoMessage() {}
+
+func (x *PromoteObserverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roomService_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteObserverResponse.ProtoReflect.Descriptor instead.
+func (*PromoteObserverResponse) Descriptor() ([]byte, []int) {
+	return file_roomService_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PromoteObserverResponse) GetErrors() []*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+var File_roomService_proto protoreflect.FileDescriptor
+
+var file_roomService_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x72, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
+	0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
 	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
 	0x74, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x41, 0x73, 0x53, 0x79, 0x73, 0x74, 0x65,
 	0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
-	0x41, 0x73, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x41, 0x73, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x41, 0x73, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x41, 0x73, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22,
 	0x3f, 0x0a, 0x0c, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x1b, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
 	0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68,
-	0x22, 0xb1, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
+	0x22, 0xd8, 0x04, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
 	0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x66,
 	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74,
@@ -1188,174 +4130,746 @@ var file_roomService_proto_rawDesc = []byte{
 	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x72, 0x73, 0x22, 0x67, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
-	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xab, 0x01,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x62, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x48, 0x61, 0x73, 0x68, 0x54, 0x74, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x48, 0x61, 0x73, 0x68, 0x54, 0x74, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x48, 0x61, 0x73, 0x68,
+	0x4f, 0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x48,
+	0x61, 0x73, 0x68, 0x4f, 0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x49, 0x6e,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61,
+	0x67, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x74, 0x6c, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x74, 0x6c, 0x12, 0x3b,
+	0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x12, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x24,
+	0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b,
+	0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x09, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x09, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x55, 0x6e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0d, 0x55, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0xc2,
+	0x04, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12,
+	0x14, 0x0a, 0x05, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x2c, 0x0a, 0x08, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a,
+	0x0a, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x76, 0x61, 0x74, 0x61,
+	0x72, 0x55, 0x72, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x76, 0x61, 0x74,
+	0x61, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x10, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x50, 0x69, 0x6e,
+	0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x50,
+	0x69, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x74,
+	0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x54, 0x74, 0x6c, 0x22, 0xa0, 0x02, 0x0a, 0x0d, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x09, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x35, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x0f,
+	0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x27, 0x0a, 0x08, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x08,
+	0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x12, 0x2c, 0x0a, 0x08, 0x50, 0x69, 0x6e, 0x6e,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x50, 0x69,
+	0x6e, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x11, 0x50, 0x69, 0x6e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x06,
+	0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49,
+	0x64, 0x12, 0x29, 0x0a, 0x09, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49,
+	0x44, 0x52, 0x09, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x12,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x12, 0x50, 0x69, 0x6e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x16, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x29, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44,
-	0x52, 0x02, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x55, 0x55, 0x49, 0x44, 0x52, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52,
-	0x6f, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x55, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x55, 0x6e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x74, 0x22, 0x92, 0x02, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0c, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x0c,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0c,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52,
+	0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x2b, 0x0a,
+	0x0a, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x0a,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22, 0x3f, 0x0a, 0x17, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x6a, 0x0a, 0x12, 0x53,
+	0x74, 0x61, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x29, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49,
+	0x44, 0x52, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x09,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x09, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
+	0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x22, 0x9b, 0x02, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x73, 0x42, 0x79, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x52,
+	0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x12, 0x28, 0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x57, 0x69,
+	0x74, 0x68, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x57, 0x69, 0x74, 0x68, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61,
+	0x67, 0x73, 0x22, 0x70, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x42, 0x79,
+	0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x05, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x24,
+	0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x35, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x09, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x57, 0x69, 0x74, 0x68,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0xf8, 0x01, 0x0a, 0x16, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
 	0x1b, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x14, 0x0a, 0x05,
-	0x41, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x41, 0x75, 0x64,
-	0x69, 0x6f, 0x12, 0x2c, 0x0a, 0x08, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x3e, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x0b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73,
-	0x22, 0xe3, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x42, 0x79, 0x43,
-	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64,
-	0x12, 0x35, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x09, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
-	0x57, 0x69, 0x74, 0x68, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f,
-	0x57, 0x69, 0x74, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x22, 0x70, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f,
-	0x6d, 0x73, 0x42, 0x79, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x52, 0x6f, 0x6f,
-	0x6d, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x54, 0x65, 0x78, 0x74, 0x12, 0x35, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x0f, 0x53, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22,
+	0x9f, 0x03, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x12,
+	0x1b, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x76, 0x61, 0x74,
+	0x61, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x08, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x0e, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e,
+	0x4c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x41, 0x74, 0x12, 0x3f,
+	0x0a, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
+	0x73, 0x22, 0x89, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x05, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x05, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xd6, 0x01,
+	0x0a, 0x14, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55,
+	0x55, 0x49, 0x44, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a,
+	0x0b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x12, 0x49, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55,
+	0x49, 0x44, 0x52, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x15, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x05, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x24, 0x0a,
+	0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x3b, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x11,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x84, 0x03, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x34,
+	0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x09, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x72,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x41, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x32, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x54, 0x61,
+	0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x54, 0x61, 0x67,
+	0x73, 0x12, 0x3b, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x12, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x3a,
+	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x11, 0x52,
+	0x65, 0x6f, 0x70, 0x65, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x52,
+	0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44,
+	0x52, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x12, 0x52, 0x65, 0x6f, 0x70, 0x65, 0x6e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x22, 0x98, 0x01, 0x0a, 0x12, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3b,
+	0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x13, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x14, 0x52, 0x6f, 0x6f,
-	0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x23, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xec, 0x03, 0x0a, 0x1a, 0x53, 0x65, 0x6e,
+	0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06,
-	0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x72, 0x73, 0x22, 0x6b, 0x0a, 0x15, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
-	0x05, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x22, 0x59, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18,
+	0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65,
+	0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x45,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x12,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x12, 0x28, 0x0a, 0x06, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x06, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x74,
+	0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x54, 0x74, 0x6c, 0x1a, 0x39, 0x0a, 0x0b,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5c, 0x0a, 0x1b, 0x53, 0x65, 0x6e, 0x64, 0x43,
+	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68,
+	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x35, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0xb3, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x24, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44,
+	0x52, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x0e, 0x48, 0x65, 0x6c, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x0e, 0x48, 0x65, 0x6c, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22, 0xe6, 0x01, 0x0a, 0x10, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1b, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x06,
+	0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49,
+	0x64, 0x12, 0x29, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49,
+	0x44, 0x52, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x06,
+	0x53, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06,
+	0x53, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x6d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52,
+	0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0x79, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x79, 0x0a,
+	0x1d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x12, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x55, 0x55, 0x49, 0x44, 0x52, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x1e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x22, 0x97, 0x02, 0x0a, 0x0b, 0x48, 0x65, 0x6c, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1b, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d,
+	0x49, 0x64, 0x12, 0x29, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55,
+	0x49, 0x44, 0x52, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a,
+	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a,
+	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7a, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x48, 0x65, 0x6c, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49,
+	0x44, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x12, 0x49, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55,
+	0x49, 0x44, 0x52, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x48, 0x65, 0x6c,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x6c, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x24, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x48, 0x65, 0x6c, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x12, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x41, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x65, 0x6c, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a,
+	0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x09,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x09, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x09, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x09, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x66, 0x0a, 0x15, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55,
-	0x49, 0x44, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x11,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x49, 0x44, 0x52, 0x08, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49,
+	0x64, 0x12, 0x29, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49,
+	0x44, 0x52, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xca, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1b, 0x0a, 0x02,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0f, 0x53, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52,
+	0x0f, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x3b, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x12, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x22, 0xd2, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55,
+	0x49, 0x44, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x2e, 0x0a, 0x07, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0x34, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xd8, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44,
+	0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x50, 0x61, 0x67, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x3b, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f,
+	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x12, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0xa9, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x67,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x50, 0x61,
+	0x67, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x24, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xc0, 0x01,
+	0x0a, 0x1c, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29,
+	0x0a, 0x09, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x09,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x12, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x45, 0x0a, 0x1d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x24, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
-	0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xd2, 0x02, 0x0a, 0x1a, 0x53, 0x65, 0x6e, 0x64,
-	0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64,
-	0x12, 0x23, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x52,
-	0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65, 0x78,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x45, 0x0a,
-	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xd3, 0x01, 0x0a, 0x16, 0x52, 0x6f, 0x6f, 0x6d,
+	0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52,
+	0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x3b, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x3f, 0x0a,
+	0x17, 0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x81,
+	0x02, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55,
+	0x55, 0x49, 0x44, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x46,
+	0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x46, 0x72, 0x6f, 0x6d, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x09, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x12,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0x3c, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x22, 0xc1, 0x01, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x4f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x52,
+	0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64,
+	0x12, 0x31, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44,
+	0x52, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x4f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x32, 0x8c, 0x0f, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x3f,
+	0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x48, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x42, 0x79, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x42, 0x79, 0x43, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x42, 0x79, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x6f,
+	0x6f, 0x6d, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a,
+	0x52, 0x65, 0x6f, 0x70, 0x65, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6f, 0x70, 0x65, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6f,
+	0x70, 0x65, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x46, 0x0a, 0x0b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
+	0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x10, 0x53, 0x65, 0x6e,
+	0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x12, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x64, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5c, 0x0a, 0x1b,
-	0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x17, 0x53,
-	0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x0f, 0x53, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x36, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3f, 0x0a, 0x17, 0x53, 0x65, 0x6e,
-	0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x16, 0x52,
-	0x6f, 0x6f, 0x6d, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x55,
-	0x49, 0x44, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x09,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x17, 0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x6e, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
-	0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x32, 0xd1, 0x03, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x3f, 0x0a,
-	0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48,
-	0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42,
-	0x79, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x42, 0x79, 0x43, 0x72, 0x69, 0x74,
-	0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x42, 0x79, 0x43, 0x72,
-	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x40, 0x0a, 0x09, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x17, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x54, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
-	0x6f, 0x6f, 0x6d, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0d, 0x5a, 0x0b, 0x63, 0x68, 0x61, 0x74,
-	0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4e, 0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x6e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x45, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a,
+	0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x48, 0x65, 0x6c,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x6c, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x6c, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x48, 0x65, 0x6c, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x65,
+	0x6c, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x48,
+	0x65, 0x6c, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a,
+	0x0c, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d,
+	0x55, 0x6e, 0x73, 0x74, 0x61, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64,
+	0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x0d, 0x5a, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x73, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1370,81 +4884,266 @@ func file_roomService_proto_rawDescGZIP() []byte {
 	return file_roomService_proto_rawDescData
 }
 
-var file_roomService_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_roomService_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_roomService_proto_goTypes = []interface{}{
-	(*SubscriberRequest)(nil),           // 0: proto.SubscriberRequest
-	(*RoomResponse)(nil),                // 1: proto.RoomResponse
-	(*CreateRoomRequest)(nil),           // 2: proto.CreateRoomRequest
-	(*CreateRoomResponse)(nil),          // 3: proto.CreateRoomResponse
-	(*GetSubscriberResponse)(nil),       // 4: proto.GetSubscriberResponse
-	(*GetRoomResponse)(nil),             // 5: proto.GetRoomResponse
-	(*GetRoomsByCriteriaRequest)(nil),   // 6: proto.GetRoomsByCriteriaRequest
-	(*GetRoomsByCriteriaResponse)(nil),  // 7: proto.GetRoomsByCriteriaResponse
-	(*RoomSubscribeRequest)(nil),        // 8: proto.RoomSubscribeRequest
-	(*RoomSubscribeResponse)(nil),       // 9: proto.RoomSubscribeResponse
-	(*CloseRoomRequest)(nil),            // 10: proto.CloseRoomRequest
-	(*CloseRoomResponse)(nil),           // 11: proto.CloseRoomResponse
-	(*SendChatMessageDataRequest)(nil),  // 12: proto.SendChatMessageDataRequest
-	(*SendChatMessagesDataRequest)(nil), // 13: proto.SendChatMessagesDataRequest
-	(*SendChatMessagesRequest)(nil),     // 14: proto.SendChatMessagesRequest
-	(*SendChatMessageResponse)(nil),     // 15: proto.SendChatMessageResponse
-	(*RoomUnsubscribeRequest)(nil),      // 16: proto.RoomUnsubscribeRequest
-	(*RoomUnsubscribeResponse)(nil),     // 17: proto.RoomUnsubscribeResponse
-	nil,                                 // 18: proto.SendChatMessageDataRequest.ParamsEntry
-	(*AccountIdRequest)(nil),            // 19: proto.AccountIdRequest
-	(*UUID)(nil),                        // 20: proto.UUID
-	(*Error)(nil),                       // 21: proto.Error
-	(*Timestamp)(nil),                   // 22: proto.Timestamp
+	(*SubscriberRequest)(nil),              // 0: proto.SubscriberRequest
+	(*RoomResponse)(nil),                   // 1: proto.RoomResponse
+	(*CreateRoomRequest)(nil),              // 2: proto.CreateRoomRequest
+	(*CreateRoomResponse)(nil),             // 3: proto.CreateRoomResponse
+	(*GetSubscriberResponse)(nil),          // 4: proto.GetSubscriberResponse
+	(*GetRoomResponse)(nil),                // 5: proto.GetRoomResponse
+	(*PinnedMessage)(nil),                  // 6: proto.PinnedMessage
+	(*PinMessageRequest)(nil),              // 7: proto.PinMessageRequest
+	(*PinMessageResponse)(nil),             // 8: proto.PinMessageResponse
+	(*ForwardMessagesRequest)(nil),         // 9: proto.ForwardMessagesRequest
+	(*ForwardMessagesResponse)(nil),        // 10: proto.ForwardMessagesResponse
+	(*StarMessageRequest)(nil),             // 11: proto.StarMessageRequest
+	(*StarMessageResponse)(nil),            // 12: proto.StarMessageResponse
+	(*GetRoomsByCriteriaRequest)(nil),      // 13: proto.GetRoomsByCriteriaRequest
+	(*GetRoomsByCriteriaResponse)(nil),     // 14: proto.GetRoomsByCriteriaResponse
+	(*GetAccountRoomsRequest)(nil),         // 15: proto.GetAccountRoomsRequest
+	(*AccountRoomLastMessage)(nil),         // 16: proto.AccountRoomLastMessage
+	(*AccountRoom)(nil),                    // 17: proto.AccountRoom
+	(*GetAccountRoomsResponse)(nil),        // 18: proto.GetAccountRoomsResponse
+	(*RoomSubscribeRequest)(nil),           // 19: proto.RoomSubscribeRequest
+	(*RoomSubscribeResponse)(nil),          // 20: proto.RoomSubscribeResponse
+	(*CloseRoomRequest)(nil),               // 21: proto.CloseRoomRequest
+	(*CloseRoomResponse)(nil),              // 22: proto.CloseRoomResponse
+	(*UpdateRoomRequest)(nil),              // 23: proto.UpdateRoomRequest
+	(*UpdateRoomResponse)(nil),             // 24: proto.UpdateRoomResponse
+	(*ReopenRoomRequest)(nil),              // 25: proto.ReopenRoomRequest
+	(*ReopenRoomResponse)(nil),             // 26: proto.ReopenRoomResponse
+	(*ArchiveRoomRequest)(nil),             // 27: proto.ArchiveRoomRequest
+	(*ArchiveRoomResponse)(nil),            // 28: proto.ArchiveRoomResponse
+	(*SendChatMessageDataRequest)(nil),     // 29: proto.SendChatMessageDataRequest
+	(*SendChatMessagesDataRequest)(nil),    // 30: proto.SendChatMessagesDataRequest
+	(*SendChatMessagesRequest)(nil),        // 31: proto.SendChatMessagesRequest
+	(*SendChatMessageResponse)(nil),        // 32: proto.SendChatMessageResponse
+	(*ScheduledMessage)(nil),               // 33: proto.ScheduledMessage
+	(*GetScheduledMessagesRequest)(nil),    // 34: proto.GetScheduledMessagesRequest
+	(*GetScheduledMessagesResponse)(nil),   // 35: proto.GetScheduledMessagesResponse
+	(*CancelScheduledMessageRequest)(nil),  // 36: proto.CancelScheduledMessageRequest
+	(*CancelScheduledMessageResponse)(nil), // 37: proto.CancelScheduledMessageResponse
+	(*HeldMessage)(nil),                    // 38: proto.HeldMessage
+	(*GetHeldMessagesRequest)(nil),         // 39: proto.GetHeldMessagesRequest
+	(*GetHeldMessagesResponse)(nil),        // 40: proto.GetHeldMessagesResponse
+	(*ReviewHeldMessageRequest)(nil),       // 41: proto.ReviewHeldMessageRequest
+	(*ReviewHeldMessageResponse)(nil),      // 42: proto.ReviewHeldMessageResponse
+	(*ReportMessageRequest)(nil),           // 43: proto.ReportMessageRequest
+	(*ReportMessageResponse)(nil),          // 44: proto.ReportMessageResponse
+	(*MessageReport)(nil),                  // 45: proto.MessageReport
+	(*ReportedMessageItem)(nil),            // 46: proto.ReportedMessageItem
+	(*ReportedMessage)(nil),                // 47: proto.ReportedMessage
+	(*GetMessageReportsRequest)(nil),       // 48: proto.GetMessageReportsRequest
+	(*GetMessageReportsResponse)(nil),      // 49: proto.GetMessageReportsResponse
+	(*ResolveMessageReportsRequest)(nil),   // 50: proto.ResolveMessageReportsRequest
+	(*ResolveMessageReportsResponse)(nil),  // 51: proto.ResolveMessageReportsResponse
+	(*RoomUnsubscribeRequest)(nil),         // 52: proto.RoomUnsubscribeRequest
+	(*RoomUnsubscribeResponse)(nil),        // 53: proto.RoomUnsubscribeResponse
+	(*TransferRoomRequest)(nil),            // 54: proto.TransferRoomRequest
+	(*TransferRoomResponse)(nil),           // 55: proto.TransferRoomResponse
+	(*PromoteObserverRequest)(nil),         // 56: proto.PromoteObserverRequest
+	(*PromoteObserverResponse)(nil),        // 57: proto.PromoteObserverResponse
+	nil,                                    // 58: proto.SendChatMessageDataRequest.ParamsEntry
+	(*AccountIdRequest)(nil),               // 59: proto.AccountIdRequest
+	(*UUID)(nil),                           // 60: proto.UUID
+	(*Timestamp)(nil),                      // 61: proto.Timestamp
+	(*Error)(nil),                          // 62: proto.Error
+	(*StringValue)(nil),                    // 63: proto.StringValue
+	(*StringList)(nil),                     // 64: proto.StringList
 }
 var file_roomService_proto_depIdxs = []int32{
-	19, // 0: proto.SubscriberRequest.Account:type_name -> proto.AccountIdRequest
-	20, // 1: proto.RoomResponse.Id:type_name -> proto.UUID
-	0,  // 2: proto.CreateRoomRequest.Subscribers:type_name -> proto.SubscriberRequest
-	1,  // 3: proto.CreateRoomResponse.Result:type_name -> proto.RoomResponse
-	21, // 4: proto.CreateRoomResponse.Errors:type_name -> proto.Error
-	20, // 5: proto.GetSubscriberResponse.Id:type_name -> proto.UUID
-	20, // 6: proto.GetSubscriberResponse.AccountId:type_name -> proto.UUID
-	22, // 7: proto.GetSubscriberResponse.UnSubscribeAt:type_name -> proto.Timestamp
-	20, // 8: proto.GetRoomResponse.Id:type_name -> proto.UUID
-	22, // 9: proto.GetRoomResponse.ClosedAt:type_name -> proto.Timestamp
-	4,  // 10: proto.GetRoomResponse.Subscribers:type_name -> proto.GetSubscriberResponse
-	19, // 11: proto.GetRoomsByCriteriaRequest.AccountId:type_name -> proto.AccountIdRequest
-	20, // 12: proto.GetRoomsByCriteriaRequest.RoomId:type_name -> proto.UUID
-	5,  // 13: proto.GetRoomsByCriteriaResponse.Rooms:type_name -> proto.GetRoomResponse
-	21, // 14: proto.GetRoomsByCriteriaResponse.Errors:type_name -> proto.Error
-	20, // 15: proto.RoomSubscribeRequest.RoomId:type_name -> proto.UUID
-	0,  // 16: proto.RoomSubscribeRequest.Subscribers:type_name -> proto.SubscriberRequest
-	5,  // 17: proto.RoomSubscribeResponse.Rooms:type_name -> proto.GetRoomResponse
-	21, // 18: proto.RoomSubscribeResponse.Errors:type_name -> proto.Error
-	20, // 19: proto.CloseRoomRequest.RoomId:type_name -> proto.UUID
-	21, // 20: proto.CloseRoomResponse.Errors:type_name -> proto.Error
-	20, // 21: proto.SendChatMessageDataRequest.RoomId:type_name -> proto.UUID
-	18, // 22: proto.SendChatMessageDataRequest.Params:type_name -> proto.SendChatMessageDataRequest.ParamsEntry
-	20, // 23: proto.SendChatMessageDataRequest.RecipientAccountId:type_name -> proto.UUID
-	12, // 24: proto.SendChatMessagesDataRequest.Messages:type_name -> proto.SendChatMessageDataRequest
-	20, // 25: proto.SendChatMessagesRequest.SenderAccountId:type_name -> proto.UUID
-	13, // 26: proto.SendChatMessagesRequest.Data:type_name -> proto.SendChatMessagesDataRequest
-	21, // 27: proto.SendChatMessageResponse.Errors:type_name -> proto.Error
-	20, // 28: proto.RoomUnsubscribeRequest.RoomId:type_name -> proto.UUID
-	19, // 29: proto.RoomUnsubscribeRequest.AccountId:type_name -> proto.AccountIdRequest
-	21, // 30: proto.RoomUnsubscribeResponse.Errors:type_name -> proto.Error
-	2,  // 31: proto.Room.Create:input_type -> proto.CreateRoomRequest
-	8,  // 32: proto.Room.Subscribe:input_type -> proto.RoomSubscribeRequest
-	6,  // 33: proto.Room.GetByCriteria:input_type -> proto.GetRoomsByCriteriaRequest
-	10, // 34: proto.Room.CloseRoom:input_type -> proto.CloseRoomRequest
-	14, // 35: proto.Room.SendChatMessages:input_type -> proto.SendChatMessagesRequest
-	16, // 36: proto.Room.Unsubscribe:input_type -> proto.RoomUnsubscribeRequest
-	3,  // 37: proto.Room.Create:output_type -> proto.CreateRoomResponse
-	9,  // 38: proto.Room.Subscribe:output_type -> proto.RoomSubscribeResponse
-	7,  // 39: proto.Room.GetByCriteria:output_type -> proto.GetRoomsByCriteriaResponse
-	11, // 40: proto.Room.CloseRoom:output_type -> proto.CloseRoomResponse
-	15, // 41: proto.Room.SendChatMessages:output_type -> proto.SendChatMessageResponse
-	17, // 42: proto.Room.Unsubscribe:output_type -> proto.RoomUnsubscribeResponse
-	37, // [37:43] is the sub-list for method output_type
-	31, // [31:37] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	59,  // 0: proto.SubscriberRequest.Account:type_name -> proto.AccountIdRequest
+	60,  // 1: proto.RoomResponse.Id:type_name -> proto.UUID
+	0,   // 2: proto.CreateRoomRequest.Subscribers:type_name -> proto.SubscriberRequest
+	61,  // 3: proto.CreateRoomRequest.CloseAt:type_name -> proto.Timestamp
+	60,  // 4: proto.CreateRoomRequest.InitiatorAccountId:type_name -> proto.UUID
+	1,   // 5: proto.CreateRoomResponse.Result:type_name -> proto.RoomResponse
+	62,  // 6: proto.CreateRoomResponse.Errors:type_name -> proto.Error
+	60,  // 7: proto.GetSubscriberResponse.Id:type_name -> proto.UUID
+	60,  // 8: proto.GetSubscriberResponse.AccountId:type_name -> proto.UUID
+	61,  // 9: proto.GetSubscriberResponse.UnSubscribeAt:type_name -> proto.Timestamp
+	60,  // 10: proto.GetRoomResponse.Id:type_name -> proto.UUID
+	61,  // 11: proto.GetRoomResponse.ClosedAt:type_name -> proto.Timestamp
+	4,   // 12: proto.GetRoomResponse.Subscribers:type_name -> proto.GetSubscriberResponse
+	61,  // 13: proto.GetRoomResponse.ArchivedAt:type_name -> proto.Timestamp
+	6,   // 14: proto.GetRoomResponse.Pins:type_name -> proto.PinnedMessage
+	60,  // 15: proto.PinnedMessage.MessageId:type_name -> proto.UUID
+	60,  // 16: proto.PinnedMessage.SenderAccountId:type_name -> proto.UUID
+	61,  // 17: proto.PinnedMessage.CreatedAt:type_name -> proto.Timestamp
+	60,  // 18: proto.PinnedMessage.PinnedBy:type_name -> proto.UUID
+	61,  // 19: proto.PinnedMessage.PinnedAt:type_name -> proto.Timestamp
+	60,  // 20: proto.PinMessageRequest.RoomId:type_name -> proto.UUID
+	60,  // 21: proto.PinMessageRequest.MessageId:type_name -> proto.UUID
+	60,  // 22: proto.PinMessageRequest.InitiatorAccountId:type_name -> proto.UUID
+	62,  // 23: proto.PinMessageResponse.Errors:type_name -> proto.Error
+	60,  // 24: proto.ForwardMessagesRequest.AccountId:type_name -> proto.UUID
+	60,  // 25: proto.ForwardMessagesRequest.SourceRoomId:type_name -> proto.UUID
+	60,  // 26: proto.ForwardMessagesRequest.TargetRoomId:type_name -> proto.UUID
+	60,  // 27: proto.ForwardMessagesRequest.MessageIds:type_name -> proto.UUID
+	62,  // 28: proto.ForwardMessagesResponse.Errors:type_name -> proto.Error
+	60,  // 29: proto.StarMessageRequest.AccountId:type_name -> proto.UUID
+	60,  // 30: proto.StarMessageRequest.MessageId:type_name -> proto.UUID
+	62,  // 31: proto.StarMessageResponse.Errors:type_name -> proto.Error
+	59,  // 32: proto.GetRoomsByCriteriaRequest.AccountId:type_name -> proto.AccountIdRequest
+	60,  // 33: proto.GetRoomsByCriteriaRequest.RoomId:type_name -> proto.UUID
+	5,   // 34: proto.GetRoomsByCriteriaResponse.Rooms:type_name -> proto.GetRoomResponse
+	62,  // 35: proto.GetRoomsByCriteriaResponse.Errors:type_name -> proto.Error
+	59,  // 36: proto.GetAccountRoomsRequest.AccountId:type_name -> proto.AccountIdRequest
+	60,  // 37: proto.AccountRoomLastMessage.Id:type_name -> proto.UUID
+	60,  // 38: proto.AccountRoomLastMessage.SenderAccountId:type_name -> proto.UUID
+	61,  // 39: proto.AccountRoomLastMessage.CreatedAt:type_name -> proto.Timestamp
+	60,  // 40: proto.AccountRoom.Id:type_name -> proto.UUID
+	61,  // 41: proto.AccountRoom.ClosedAt:type_name -> proto.Timestamp
+	61,  // 42: proto.AccountRoom.LastActivityAt:type_name -> proto.Timestamp
+	16,  // 43: proto.AccountRoom.LastMessage:type_name -> proto.AccountRoomLastMessage
+	4,   // 44: proto.AccountRoom.Subscribers:type_name -> proto.GetSubscriberResponse
+	17,  // 45: proto.GetAccountRoomsResponse.Rooms:type_name -> proto.AccountRoom
+	62,  // 46: proto.GetAccountRoomsResponse.Errors:type_name -> proto.Error
+	60,  // 47: proto.RoomSubscribeRequest.RoomId:type_name -> proto.UUID
+	0,   // 48: proto.RoomSubscribeRequest.Subscribers:type_name -> proto.SubscriberRequest
+	60,  // 49: proto.RoomSubscribeRequest.InitiatorAccountId:type_name -> proto.UUID
+	5,   // 50: proto.RoomSubscribeResponse.Rooms:type_name -> proto.GetRoomResponse
+	62,  // 51: proto.RoomSubscribeResponse.Errors:type_name -> proto.Error
+	60,  // 52: proto.CloseRoomRequest.RoomId:type_name -> proto.UUID
+	60,  // 53: proto.CloseRoomRequest.InitiatorAccountId:type_name -> proto.UUID
+	62,  // 54: proto.CloseRoomResponse.Errors:type_name -> proto.Error
+	60,  // 55: proto.UpdateRoomRequest.RoomId:type_name -> proto.UUID
+	63,  // 56: proto.UpdateRoomRequest.Title:type_name -> proto.StringValue
+	63,  // 57: proto.UpdateRoomRequest.Description:type_name -> proto.StringValue
+	63,  // 58: proto.UpdateRoomRequest.AvatarUrl:type_name -> proto.StringValue
+	63,  // 59: proto.UpdateRoomRequest.Attributes:type_name -> proto.StringValue
+	64,  // 60: proto.UpdateRoomRequest.Tags:type_name -> proto.StringList
+	60,  // 61: proto.UpdateRoomRequest.InitiatorAccountId:type_name -> proto.UUID
+	62,  // 62: proto.UpdateRoomResponse.Errors:type_name -> proto.Error
+	60,  // 63: proto.ReopenRoomRequest.RoomId:type_name -> proto.UUID
+	60,  // 64: proto.ReopenRoomRequest.InitiatorAccountId:type_name -> proto.UUID
+	62,  // 65: proto.ReopenRoomResponse.Errors:type_name -> proto.Error
+	60,  // 66: proto.ArchiveRoomRequest.RoomId:type_name -> proto.UUID
+	60,  // 67: proto.ArchiveRoomRequest.InitiatorAccountId:type_name -> proto.UUID
+	62,  // 68: proto.ArchiveRoomResponse.Errors:type_name -> proto.Error
+	60,  // 69: proto.SendChatMessageDataRequest.RoomId:type_name -> proto.UUID
+	58,  // 70: proto.SendChatMessageDataRequest.Params:type_name -> proto.SendChatMessageDataRequest.ParamsEntry
+	60,  // 71: proto.SendChatMessageDataRequest.RecipientAccountId:type_name -> proto.UUID
+	61,  // 72: proto.SendChatMessageDataRequest.SendAt:type_name -> proto.Timestamp
+	29,  // 73: proto.SendChatMessagesDataRequest.Messages:type_name -> proto.SendChatMessageDataRequest
+	60,  // 74: proto.SendChatMessagesRequest.SenderAccountId:type_name -> proto.UUID
+	30,  // 75: proto.SendChatMessagesRequest.Data:type_name -> proto.SendChatMessagesDataRequest
+	62,  // 76: proto.SendChatMessageResponse.Errors:type_name -> proto.Error
+	60,  // 77: proto.SendChatMessageResponse.ScheduledMessageIds:type_name -> proto.UUID
+	60,  // 78: proto.SendChatMessageResponse.HeldMessageIds:type_name -> proto.UUID
+	60,  // 79: proto.ScheduledMessage.Id:type_name -> proto.UUID
+	60,  // 80: proto.ScheduledMessage.RoomId:type_name -> proto.UUID
+	60,  // 81: proto.ScheduledMessage.AccountId:type_name -> proto.UUID
+	61,  // 82: proto.ScheduledMessage.SendAt:type_name -> proto.Timestamp
+	29,  // 83: proto.ScheduledMessage.Message:type_name -> proto.SendChatMessageDataRequest
+	60,  // 84: proto.GetScheduledMessagesRequest.RoomId:type_name -> proto.UUID
+	60,  // 85: proto.GetScheduledMessagesRequest.AccountId:type_name -> proto.UUID
+	33,  // 86: proto.GetScheduledMessagesResponse.Messages:type_name -> proto.ScheduledMessage
+	62,  // 87: proto.GetScheduledMessagesResponse.Errors:type_name -> proto.Error
+	60,  // 88: proto.CancelScheduledMessageRequest.Id:type_name -> proto.UUID
+	60,  // 89: proto.CancelScheduledMessageRequest.InitiatorAccountId:type_name -> proto.UUID
+	62,  // 90: proto.CancelScheduledMessageResponse.Errors:type_name -> proto.Error
+	60,  // 91: proto.HeldMessage.Id:type_name -> proto.UUID
+	60,  // 92: proto.HeldMessage.RoomId:type_name -> proto.UUID
+	60,  // 93: proto.HeldMessage.AccountId:type_name -> proto.UUID
+	61,  // 94: proto.HeldMessage.CreatedAt:type_name -> proto.Timestamp
+	29,  // 95: proto.HeldMessage.Message:type_name -> proto.SendChatMessageDataRequest
+	60,  // 96: proto.GetHeldMessagesRequest.RoomId:type_name -> proto.UUID
+	60,  // 97: proto.GetHeldMessagesRequest.InitiatorAccountId:type_name -> proto.UUID
+	38,  // 98: proto.GetHeldMessagesResponse.Messages:type_name -> proto.HeldMessage
+	62,  // 99: proto.GetHeldMessagesResponse.Errors:type_name -> proto.Error
+	60,  // 100: proto.ReviewHeldMessageRequest.Id:type_name -> proto.UUID
+	60,  // 101: proto.ReviewHeldMessageRequest.InitiatorAccountId:type_name -> proto.UUID
+	62,  // 102: proto.ReviewHeldMessageResponse.Errors:type_name -> proto.Error
+	60,  // 103: proto.ReportMessageRequest.AccountId:type_name -> proto.UUID
+	60,  // 104: proto.ReportMessageRequest.MessageId:type_name -> proto.UUID
+	60,  // 105: proto.ReportMessageResponse.ReportId:type_name -> proto.UUID
+	62,  // 106: proto.ReportMessageResponse.Errors:type_name -> proto.Error
+	60,  // 107: proto.MessageReport.Id:type_name -> proto.UUID
+	60,  // 108: proto.MessageReport.AccountId:type_name -> proto.UUID
+	61,  // 109: proto.MessageReport.CreatedAt:type_name -> proto.Timestamp
+	60,  // 110: proto.ReportedMessageItem.Id:type_name -> proto.UUID
+	60,  // 111: proto.ReportedMessageItem.SenderAccountId:type_name -> proto.UUID
+	60,  // 112: proto.ReportedMessageItem.RecipientAccountId:type_name -> proto.UUID
+	61,  // 113: proto.ReportedMessageItem.CreatedAt:type_name -> proto.Timestamp
+	60,  // 114: proto.ReportedMessage.RoomId:type_name -> proto.UUID
+	46,  // 115: proto.ReportedMessage.Message:type_name -> proto.ReportedMessageItem
+	45,  // 116: proto.ReportedMessage.Reports:type_name -> proto.MessageReport
+	46,  // 117: proto.ReportedMessage.Context:type_name -> proto.ReportedMessageItem
+	60,  // 118: proto.GetMessageReportsRequest.RoomId:type_name -> proto.UUID
+	60,  // 119: proto.GetMessageReportsRequest.InitiatorAccountId:type_name -> proto.UUID
+	47,  // 120: proto.GetMessageReportsResponse.Messages:type_name -> proto.ReportedMessage
+	62,  // 121: proto.GetMessageReportsResponse.Errors:type_name -> proto.Error
+	60,  // 122: proto.ResolveMessageReportsRequest.MessageId:type_name -> proto.UUID
+	60,  // 123: proto.ResolveMessageReportsRequest.InitiatorAccountId:type_name -> proto.UUID
+	62,  // 124: proto.ResolveMessageReportsResponse.Errors:type_name -> proto.Error
+	60,  // 125: proto.RoomUnsubscribeRequest.RoomId:type_name -> proto.UUID
+	59,  // 126: proto.RoomUnsubscribeRequest.AccountId:type_name -> proto.AccountIdRequest
+	60,  // 127: proto.RoomUnsubscribeRequest.InitiatorAccountId:type_name -> proto.UUID
+	62,  // 128: proto.RoomUnsubscribeResponse.Errors:type_name -> proto.Error
+	60,  // 129: proto.TransferRoomRequest.RoomId:type_name -> proto.UUID
+	59,  // 130: proto.TransferRoomRequest.FromAccount:type_name -> proto.AccountIdRequest
+	59,  // 131: proto.TransferRoomRequest.ToAccount:type_name -> proto.AccountIdRequest
+	60,  // 132: proto.TransferRoomRequest.InitiatorAccountId:type_name -> proto.UUID
+	62,  // 133: proto.TransferRoomResponse.Errors:type_name -> proto.Error
+	60,  // 134: proto.PromoteObserverRequest.RoomId:type_name -> proto.UUID
+	59,  // 135: proto.PromoteObserverRequest.Account:type_name -> proto.AccountIdRequest
+	60,  // 136: proto.PromoteObserverRequest.InitiatorAccountId:type_name -> proto.UUID
+	62,  // 137: proto.PromoteObserverResponse.Errors:type_name -> proto.Error
+	2,   // 138: proto.Room.Create:input_type -> proto.CreateRoomRequest
+	19,  // 139: proto.Room.Subscribe:input_type -> proto.RoomSubscribeRequest
+	13,  // 140: proto.Room.GetByCriteria:input_type -> proto.GetRoomsByCriteriaRequest
+	15,  // 141: proto.Room.GetAccountRooms:input_type -> proto.GetAccountRoomsRequest
+	21,  // 142: proto.Room.CloseRoom:input_type -> proto.CloseRoomRequest
+	23,  // 143: proto.Room.UpdateRoom:input_type -> proto.UpdateRoomRequest
+	25,  // 144: proto.Room.ReopenRoom:input_type -> proto.ReopenRoomRequest
+	27,  // 145: proto.Room.ArchiveRoom:input_type -> proto.ArchiveRoomRequest
+	31,  // 146: proto.Room.SendChatMessages:input_type -> proto.SendChatMessagesRequest
+	52,  // 147: proto.Room.Unsubscribe:input_type -> proto.RoomUnsubscribeRequest
+	54,  // 148: proto.Room.Transfer:input_type -> proto.TransferRoomRequest
+	56,  // 149: proto.Room.PromoteObserver:input_type -> proto.PromoteObserverRequest
+	34,  // 150: proto.Room.GetScheduledMessages:input_type -> proto.GetScheduledMessagesRequest
+	36,  // 151: proto.Room.CancelScheduledMessage:input_type -> proto.CancelScheduledMessageRequest
+	39,  // 152: proto.Room.GetHeldMessages:input_type -> proto.GetHeldMessagesRequest
+	41,  // 153: proto.Room.ReviewHeldMessage:input_type -> proto.ReviewHeldMessageRequest
+	9,   // 154: proto.Room.ForwardMessages:input_type -> proto.ForwardMessagesRequest
+	7,   // 155: proto.Room.PinMessage:input_type -> proto.PinMessageRequest
+	7,   // 156: proto.Room.UnpinMessage:input_type -> proto.PinMessageRequest
+	11,  // 157: proto.Room.StarMessage:input_type -> proto.StarMessageRequest
+	11,  // 158: proto.Room.UnstarMessage:input_type -> proto.StarMessageRequest
+	43,  // 159: proto.Room.ReportMessage:input_type -> proto.ReportMessageRequest
+	48,  // 160: proto.Room.GetMessageReports:input_type -> proto.GetMessageReportsRequest
+	50,  // 161: proto.Room.ResolveMessageReports:input_type -> proto.ResolveMessageReportsRequest
+	3,   // 162: proto.Room.Create:output_type -> proto.CreateRoomResponse
+	20,  // 163: proto.Room.Subscribe:output_type -> proto.RoomSubscribeResponse
+	14,  // 164: proto.Room.GetByCriteria:output_type -> proto.GetRoomsByCriteriaResponse
+	18,  // 165: proto.Room.GetAccountRooms:output_type -> proto.GetAccountRoomsResponse
+	22,  // 166: proto.Room.CloseRoom:output_type -> proto.CloseRoomResponse
+	24,  // 167: proto.Room.UpdateRoom:output_type -> proto.UpdateRoomResponse
+	26,  // 168: proto.Room.ReopenRoom:output_type -> proto.ReopenRoomResponse
+	28,  // 169: proto.Room.ArchiveRoom:output_type -> proto.ArchiveRoomResponse
+	32,  // 170: proto.Room.SendChatMessages:output_type -> proto.SendChatMessageResponse
+	53,  // 171: proto.Room.Unsubscribe:output_type -> proto.RoomUnsubscribeResponse
+	55,  // 172: proto.Room.Transfer:output_type -> proto.TransferRoomResponse
+	57,  // 173: proto.Room.PromoteObserver:output_type -> proto.PromoteObserverResponse
+	35,  // 174: proto.Room.GetScheduledMessages:output_type -> proto.GetScheduledMessagesResponse
+	37,  // 175: proto.Room.CancelScheduledMessage:output_type -> proto.CancelScheduledMessageResponse
+	40,  // 176: proto.Room.GetHeldMessages:output_type -> proto.GetHeldMessagesResponse
+	42,  // 177: proto.Room.ReviewHeldMessage:output_type -> proto.ReviewHeldMessageResponse
+	10,  // 178: proto.Room.ForwardMessages:output_type -> proto.ForwardMessagesResponse
+	8,   // 179: proto.Room.PinMessage:output_type -> proto.PinMessageResponse
+	8,   // 180: proto.Room.UnpinMessage:output_type -> proto.PinMessageResponse
+	12,  // 181: proto.Room.StarMessage:output_type -> proto.StarMessageResponse
+	12,  // 182: proto.Room.UnstarMessage:output_type -> proto.StarMessageResponse
+	44,  // 183: proto.Room.ReportMessage:output_type -> proto.ReportMessageResponse
+	49,  // 184: proto.Room.GetMessageReports:output_type -> proto.GetMessageReportsResponse
+	51,  // 185: proto.Room.ResolveMessageReports:output_type -> proto.ResolveMessageReportsResponse
+	162, // [162:186] is the sub-list for method output_type
+	138, // [138:162] is the sub-list for method input_type
+	138, // [138:138] is the sub-list for extension type_name
+	138, // [138:138] is the sub-list for extension extendee
+	0,   // [0:138] is the sub-list for field type_name
 }
 
 func init() { file_roomService_proto_init() }
@@ -1466,8 +5165,236 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoomResponse); i {
+		file_roomService_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoomResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscriberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoomResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinnedMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinMessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarMessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoomsByCriteriaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoomsByCriteriaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountRoomsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountRoomLastMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountRoom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountRoomsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomSubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomSubscribeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1478,8 +5405,8 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRoomRequest); i {
+		file_roomService_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseRoomRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1490,8 +5417,8 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRoomResponse); i {
+		file_roomService_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseRoomResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1502,8 +5429,8 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSubscriberResponse); i {
+		file_roomService_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoomRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1514,8 +5441,8 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoomResponse); i {
+		file_roomService_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoomResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1526,8 +5453,8 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoomsByCriteriaRequest); i {
+		file_roomService_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReopenRoomRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1538,8 +5465,8 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoomsByCriteriaResponse); i {
+		file_roomService_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReopenRoomResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1550,8 +5477,8 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoomSubscribeRequest); i {
+		file_roomService_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveRoomRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1562,8 +5489,8 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoomSubscribeResponse); i {
+		file_roomService_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveRoomResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1574,8 +5501,8 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseRoomRequest); i {
+		file_roomService_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendChatMessageDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1586,8 +5513,8 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseRoomResponse); i {
+		file_roomService_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendChatMessagesDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1598,8 +5525,8 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendChatMessageDataRequest); i {
+		file_roomService_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendChatMessagesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1610,8 +5537,8 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendChatMessagesDataRequest); i {
+		file_roomService_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendChatMessageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1622,8 +5549,8 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendChatMessagesRequest); i {
+		file_roomService_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1634,8 +5561,8 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendChatMessageResponse); i {
+		file_roomService_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledMessagesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1646,7 +5573,211 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_roomService_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledMessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeldMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHeldMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHeldMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewHeldMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewHeldMessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportMessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportedMessageItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportedMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessageReportsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessageReportsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveMessageReportsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveMessageReportsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RoomUnsubscribeRequest); i {
 			case 0:
 				return &v.state
@@ -1658,7 +5789,7 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
-		file_roomService_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_roomService_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RoomUnsubscribeResponse); i {
 			case 0:
 				return &v.state
@@ -1670,6 +5801,54 @@ func file_roomService_proto_init() {
 				return nil
 			}
 		}
+		file_roomService_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferRoomResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteObserverRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roomService_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteObserverResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1677,7 +5856,7 @@ func file_roomService_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_roomService_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,9 +20,27 @@ type RoomClient interface {
 	Create(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error)
 	Subscribe(ctx context.Context, in *RoomSubscribeRequest, opts ...grpc.CallOption) (*RoomSubscribeResponse, error)
 	GetByCriteria(ctx context.Context, in *GetRoomsByCriteriaRequest, opts ...grpc.CallOption) (*GetRoomsByCriteriaResponse, error)
+	GetAccountRooms(ctx context.Context, in *GetAccountRoomsRequest, opts ...grpc.CallOption) (*GetAccountRoomsResponse, error)
 	CloseRoom(ctx context.Context, in *CloseRoomRequest, opts ...grpc.CallOption) (*CloseRoomResponse, error)
+	UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*UpdateRoomResponse, error)
+	ReopenRoom(ctx context.Context, in *ReopenRoomRequest, opts ...grpc.CallOption) (*ReopenRoomResponse, error)
+	ArchiveRoom(ctx context.Context, in *ArchiveRoomRequest, opts ...grpc.CallOption) (*ArchiveRoomResponse, error)
 	SendChatMessages(ctx context.Context, in *SendChatMessagesRequest, opts ...grpc.CallOption) (*SendChatMessageResponse, error)
 	Unsubscribe(ctx context.Context, in *RoomUnsubscribeRequest, opts ...grpc.CallOption) (*RoomUnsubscribeResponse, error)
+	Transfer(ctx context.Context, in *TransferRoomRequest, opts ...grpc.CallOption) (*TransferRoomResponse, error)
+	PromoteObserver(ctx context.Context, in *PromoteObserverRequest, opts ...grpc.CallOption) (*PromoteObserverResponse, error)
+	GetScheduledMessages(ctx context.Context, in *GetScheduledMessagesRequest, opts ...grpc.CallOption) (*GetScheduledMessagesResponse, error)
+	CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*CancelScheduledMessageResponse, error)
+	GetHeldMessages(ctx context.Context, in *GetHeldMessagesRequest, opts ...grpc.CallOption) (*GetHeldMessagesResponse, error)
+	ReviewHeldMessage(ctx context.Context, in *ReviewHeldMessageRequest, opts ...grpc.CallOption) (*ReviewHeldMessageResponse, error)
+	ForwardMessages(ctx context.Context, in *ForwardMessagesRequest, opts ...grpc.CallOption) (*ForwardMessagesResponse, error)
+	PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error)
+	UnpinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error)
+	StarMessage(ctx context.Context, in *StarMessageRequest, opts ...grpc.CallOption) (*StarMessageResponse, error)
+	UnstarMessage(ctx context.Context, in *StarMessageRequest, opts ...grpc.CallOption) (*StarMessageResponse, error)
+	ReportMessage(ctx context.Context, in *ReportMessageRequest, opts ...grpc.CallOption) (*ReportMessageResponse, error)
+	GetMessageReports(ctx context.Context, in *GetMessageReportsRequest, opts ...grpc.CallOption) (*GetMessageReportsResponse, error)
+	ResolveMessageReports(ctx context.Context, in *ResolveMessageReportsRequest, opts ...grpc.CallOption) (*ResolveMessageReportsResponse, error)
 }
 
 type roomClient struct {
@@ -60,6 +78,15 @@ func (c *roomClient) GetByCriteria(ctx context.Context, in *GetRoomsByCriteriaRe
 	return out, nil
 }
 
+func (c *roomClient) GetAccountRooms(ctx context.Context, in *GetAccountRoomsRequest, opts ...grpc.CallOption) (*GetAccountRoomsResponse, error) {
+	out := new(GetAccountRoomsResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/GetAccountRooms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *roomClient) CloseRoom(ctx context.Context, in *CloseRoomRequest, opts ...grpc.CallOption) (*CloseRoomResponse, error) {
 	out := new(CloseRoomResponse)
 	err := c.cc.Invoke(ctx, "/proto.Room/CloseRoom", in, out, opts...)
@@ -69,6 +96,33 @@ func (c *roomClient) CloseRoom(ctx context.Context, in *CloseRoomRequest, opts .
 	return out, nil
 }
 
+func (c *roomClient) UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*UpdateRoomResponse, error) {
+	out := new(UpdateRoomResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/UpdateRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) ReopenRoom(ctx context.Context, in *ReopenRoomRequest, opts ...grpc.CallOption) (*ReopenRoomResponse, error) {
+	out := new(ReopenRoomResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/ReopenRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) ArchiveRoom(ctx context.Context, in *ArchiveRoomRequest, opts ...grpc.CallOption) (*ArchiveRoomResponse, error) {
+	out := new(ArchiveRoomResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/ArchiveRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *roomClient) SendChatMessages(ctx context.Context, in *SendChatMessagesRequest, opts ...grpc.CallOption) (*SendChatMessageResponse, error) {
 	out := new(SendChatMessageResponse)
 	err := c.cc.Invoke(ctx, "/proto.Room/SendChatMessages", in, out, opts...)
@@ -87,6 +141,132 @@ func (c *roomClient) Unsubscribe(ctx context.Context, in *RoomUnsubscribeRequest
 	return out, nil
 }
 
+func (c *roomClient) Transfer(ctx context.Context, in *TransferRoomRequest, opts ...grpc.CallOption) (*TransferRoomResponse, error) {
+	out := new(TransferRoomResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/Transfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) PromoteObserver(ctx context.Context, in *PromoteObserverRequest, opts ...grpc.CallOption) (*PromoteObserverResponse, error) {
+	out := new(PromoteObserverResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/PromoteObserver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) GetScheduledMessages(ctx context.Context, in *GetScheduledMessagesRequest, opts ...grpc.CallOption) (*GetScheduledMessagesResponse, error) {
+	out := new(GetScheduledMessagesResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/GetScheduledMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*CancelScheduledMessageResponse, error) {
+	out := new(CancelScheduledMessageResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/CancelScheduledMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) GetHeldMessages(ctx context.Context, in *GetHeldMessagesRequest, opts ...grpc.CallOption) (*GetHeldMessagesResponse, error) {
+	out := new(GetHeldMessagesResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/GetHeldMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) ReviewHeldMessage(ctx context.Context, in *ReviewHeldMessageRequest, opts ...grpc.CallOption) (*ReviewHeldMessageResponse, error) {
+	out := new(ReviewHeldMessageResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/ReviewHeldMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) ForwardMessages(ctx context.Context, in *ForwardMessagesRequest, opts ...grpc.CallOption) (*ForwardMessagesResponse, error) {
+	out := new(ForwardMessagesResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/ForwardMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error) {
+	out := new(PinMessageResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/PinMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) UnpinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error) {
+	out := new(PinMessageResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/UnpinMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) StarMessage(ctx context.Context, in *StarMessageRequest, opts ...grpc.CallOption) (*StarMessageResponse, error) {
+	out := new(StarMessageResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/StarMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) UnstarMessage(ctx context.Context, in *StarMessageRequest, opts ...grpc.CallOption) (*StarMessageResponse, error) {
+	out := new(StarMessageResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/UnstarMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) ReportMessage(ctx context.Context, in *ReportMessageRequest, opts ...grpc.CallOption) (*ReportMessageResponse, error) {
+	out := new(ReportMessageResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/ReportMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) GetMessageReports(ctx context.Context, in *GetMessageReportsRequest, opts ...grpc.CallOption) (*GetMessageReportsResponse, error) {
+	out := new(GetMessageReportsResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/GetMessageReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) ResolveMessageReports(ctx context.Context, in *ResolveMessageReportsRequest, opts ...grpc.CallOption) (*ResolveMessageReportsResponse, error) {
+	out := new(ResolveMessageReportsResponse)
+	err := c.cc.Invoke(ctx, "/proto.Room/ResolveMessageReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoomServer is the server API for Room service.
 // All implementations must embed UnimplementedRoomServer
 // for forward compatibility
@@ -94,9 +274,27 @@ type RoomServer interface {
 	Create(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error)
 	Subscribe(context.Context, *RoomSubscribeRequest) (*RoomSubscribeResponse, error)
 	GetByCriteria(context.Context, *GetRoomsByCriteriaRequest) (*GetRoomsByCriteriaResponse, error)
+	GetAccountRooms(context.Context, *GetAccountRoomsRequest) (*GetAccountRoomsResponse, error)
 	CloseRoom(context.Context, *CloseRoomRequest) (*CloseRoomResponse, error)
+	UpdateRoom(context.Context, *UpdateRoomRequest) (*UpdateRoomResponse, error)
+	ReopenRoom(context.Context, *ReopenRoomRequest) (*ReopenRoomResponse, error)
+	ArchiveRoom(context.Context, *ArchiveRoomRequest) (*ArchiveRoomResponse, error)
 	SendChatMessages(context.Context, *SendChatMessagesRequest) (*SendChatMessageResponse, error)
 	Unsubscribe(context.Context, *RoomUnsubscribeRequest) (*RoomUnsubscribeResponse, error)
+	Transfer(context.Context, *TransferRoomRequest) (*TransferRoomResponse, error)
+	PromoteObserver(context.Context, *PromoteObserverRequest) (*PromoteObserverResponse, error)
+	GetScheduledMessages(context.Context, *GetScheduledMessagesRequest) (*GetScheduledMessagesResponse, error)
+	CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*CancelScheduledMessageResponse, error)
+	GetHeldMessages(context.Context, *GetHeldMessagesRequest) (*GetHeldMessagesResponse, error)
+	ReviewHeldMessage(context.Context, *ReviewHeldMessageRequest) (*ReviewHeldMessageResponse, error)
+	ForwardMessages(context.Context, *ForwardMessagesRequest) (*ForwardMessagesResponse, error)
+	PinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error)
+	UnpinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error)
+	StarMessage(context.Context, *StarMessageRequest) (*StarMessageResponse, error)
+	UnstarMessage(context.Context, *StarMessageRequest) (*StarMessageResponse, error)
+	ReportMessage(context.Context, *ReportMessageRequest) (*ReportMessageResponse, error)
+	GetMessageReports(context.Context, *GetMessageReportsRequest) (*GetMessageReportsResponse, error)
+	ResolveMessageReports(context.Context, *ResolveMessageReportsRequest) (*ResolveMessageReportsResponse, error)
 	mustEmbedUnimplementedRoomServer()
 }
 
@@ -113,15 +311,69 @@ func (UnimplementedRoomServer) Subscribe(context.Context, *RoomSubscribeRequest)
 func (UnimplementedRoomServer) GetByCriteria(context.Context, *GetRoomsByCriteriaRequest) (*GetRoomsByCriteriaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByCriteria not implemented")
 }
+func (UnimplementedRoomServer) GetAccountRooms(context.Context, *GetAccountRoomsRequest) (*GetAccountRoomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountRooms not implemented")
+}
 func (UnimplementedRoomServer) CloseRoom(context.Context, *CloseRoomRequest) (*CloseRoomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseRoom not implemented")
 }
+func (UnimplementedRoomServer) UpdateRoom(context.Context, *UpdateRoomRequest) (*UpdateRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoom not implemented")
+}
+func (UnimplementedRoomServer) ReopenRoom(context.Context, *ReopenRoomRequest) (*ReopenRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenRoom not implemented")
+}
+func (UnimplementedRoomServer) ArchiveRoom(context.Context, *ArchiveRoomRequest) (*ArchiveRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveRoom not implemented")
+}
 func (UnimplementedRoomServer) SendChatMessages(context.Context, *SendChatMessagesRequest) (*SendChatMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendChatMessages not implemented")
 }
 func (UnimplementedRoomServer) Unsubscribe(context.Context, *RoomUnsubscribeRequest) (*RoomUnsubscribeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
 }
+func (UnimplementedRoomServer) Transfer(context.Context, *TransferRoomRequest) (*TransferRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedRoomServer) PromoteObserver(context.Context, *PromoteObserverRequest) (*PromoteObserverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteObserver not implemented")
+}
+func (UnimplementedRoomServer) GetScheduledMessages(context.Context, *GetScheduledMessagesRequest) (*GetScheduledMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledMessages not implemented")
+}
+func (UnimplementedRoomServer) CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*CancelScheduledMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMessage not implemented")
+}
+func (UnimplementedRoomServer) GetHeldMessages(context.Context, *GetHeldMessagesRequest) (*GetHeldMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeldMessages not implemented")
+}
+func (UnimplementedRoomServer) ReviewHeldMessage(context.Context, *ReviewHeldMessageRequest) (*ReviewHeldMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewHeldMessage not implemented")
+}
+func (UnimplementedRoomServer) ForwardMessages(context.Context, *ForwardMessagesRequest) (*ForwardMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardMessages not implemented")
+}
+func (UnimplementedRoomServer) PinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinMessage not implemented")
+}
+func (UnimplementedRoomServer) UnpinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinMessage not implemented")
+}
+func (UnimplementedRoomServer) StarMessage(context.Context, *StarMessageRequest) (*StarMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StarMessage not implemented")
+}
+func (UnimplementedRoomServer) UnstarMessage(context.Context, *StarMessageRequest) (*StarMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnstarMessage not implemented")
+}
+func (UnimplementedRoomServer) ReportMessage(context.Context, *ReportMessageRequest) (*ReportMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMessage not implemented")
+}
+func (UnimplementedRoomServer) GetMessageReports(context.Context, *GetMessageReportsRequest) (*GetMessageReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageReports not implemented")
+}
+func (UnimplementedRoomServer) ResolveMessageReports(context.Context, *ResolveMessageReportsRequest) (*ResolveMessageReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveMessageReports not implemented")
+}
 func (UnimplementedRoomServer) mustEmbedUnimplementedRoomServer() {}
 
 // UnsafeRoomServer may be embedded to opt out of forward compatibility for this service.
@@ -189,6 +441,24 @@ func _Room_GetByCriteria_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Room_GetAccountRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRoomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).GetAccountRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/GetAccountRooms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).GetAccountRooms(ctx, req.(*GetAccountRoomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Room_CloseRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CloseRoomRequest)
 	if err := dec(in); err != nil {
@@ -207,6 +477,60 @@ func _Room_CloseRoom_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Room_UpdateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).UpdateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/UpdateRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).UpdateRoom(ctx, req.(*UpdateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_ReopenRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).ReopenRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/ReopenRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).ReopenRoom(ctx, req.(*ReopenRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_ArchiveRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).ArchiveRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/ArchiveRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).ArchiveRoom(ctx, req.(*ArchiveRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Room_SendChatMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendChatMessagesRequest)
 	if err := dec(in); err != nil {
@@ -243,6 +567,258 @@ func _Room_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Room_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/Transfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).Transfer(ctx, req.(*TransferRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_PromoteObserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteObserverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).PromoteObserver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/PromoteObserver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).PromoteObserver(ctx, req.(*PromoteObserverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_GetScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).GetScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/GetScheduledMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).GetScheduledMessages(ctx, req.(*GetScheduledMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_CancelScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).CancelScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/CancelScheduledMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).CancelScheduledMessage(ctx, req.(*CancelScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_GetHeldMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHeldMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).GetHeldMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/GetHeldMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).GetHeldMessages(ctx, req.(*GetHeldMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_ReviewHeldMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewHeldMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).ReviewHeldMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/ReviewHeldMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).ReviewHeldMessage(ctx, req.(*ReviewHeldMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_ForwardMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).ForwardMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/ForwardMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).ForwardMessages(ctx, req.(*ForwardMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_PinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).PinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/PinMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).PinMessage(ctx, req.(*PinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_UnpinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).UnpinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/UnpinMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).UnpinMessage(ctx, req.(*PinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_StarMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StarMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).StarMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/StarMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).StarMessage(ctx, req.(*StarMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_UnstarMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StarMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).UnstarMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/UnstarMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).UnstarMessage(ctx, req.(*StarMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_ReportMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).ReportMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/ReportMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).ReportMessage(ctx, req.(*ReportMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_GetMessageReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).GetMessageReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/GetMessageReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).GetMessageReports(ctx, req.(*GetMessageReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_ResolveMessageReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveMessageReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).ResolveMessageReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/ResolveMessageReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).ResolveMessageReports(ctx, req.(*ResolveMessageReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Room_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto.Room",
 	HandlerType: (*RoomServer)(nil),
@@ -259,10 +835,26 @@ var _Room_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetByCriteria",
 			Handler:    _Room_GetByCriteria_Handler,
 		},
+		{
+			MethodName: "GetAccountRooms",
+			Handler:    _Room_GetAccountRooms_Handler,
+		},
 		{
 			MethodName: "CloseRoom",
 			Handler:    _Room_CloseRoom_Handler,
 		},
+		{
+			MethodName: "UpdateRoom",
+			Handler:    _Room_UpdateRoom_Handler,
+		},
+		{
+			MethodName: "ReopenRoom",
+			Handler:    _Room_ReopenRoom_Handler,
+		},
+		{
+			MethodName: "ArchiveRoom",
+			Handler:    _Room_ArchiveRoom_Handler,
+		},
 		{
 			MethodName: "SendChatMessages",
 			Handler:    _Room_SendChatMessages_Handler,
@@ -271,6 +863,62 @@ var _Room_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Unsubscribe",
 			Handler:    _Room_Unsubscribe_Handler,
 		},
+		{
+			MethodName: "Transfer",
+			Handler:    _Room_Transfer_Handler,
+		},
+		{
+			MethodName: "PromoteObserver",
+			Handler:    _Room_PromoteObserver_Handler,
+		},
+		{
+			MethodName: "GetScheduledMessages",
+			Handler:    _Room_GetScheduledMessages_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMessage",
+			Handler:    _Room_CancelScheduledMessage_Handler,
+		},
+		{
+			MethodName: "GetHeldMessages",
+			Handler:    _Room_GetHeldMessages_Handler,
+		},
+		{
+			MethodName: "ReviewHeldMessage",
+			Handler:    _Room_ReviewHeldMessage_Handler,
+		},
+		{
+			MethodName: "ForwardMessages",
+			Handler:    _Room_ForwardMessages_Handler,
+		},
+		{
+			MethodName: "PinMessage",
+			Handler:    _Room_PinMessage_Handler,
+		},
+		{
+			MethodName: "UnpinMessage",
+			Handler:    _Room_UnpinMessage_Handler,
+		},
+		{
+			MethodName: "StarMessage",
+			Handler:    _Room_StarMessage_Handler,
+		},
+		{
+			MethodName: "UnstarMessage",
+			Handler:    _Room_UnstarMessage_Handler,
+		},
+		{
+			MethodName: "ReportMessage",
+			Handler:    _Room_ReportMessage_Handler,
+		},
+		{
+			MethodName: "GetMessageReports",
+			Handler:    _Room_GetMessageReports_Handler,
+		},
+		{
+			MethodName: "ResolveMessageReports",
+			Handler:    _Room_ResolveMessageReports_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "roomService.proto",
@@ -41,6 +41,32 @@ func ToTimestamp(t *time.Time) *Timestamp {
 	}
 }
 
+// ToString returns nil if the value is omitted
+func (v *StringValue) ToString() *string {
+	if v == nil {
+		return nil
+	}
+	return &v.Value
+}
+
+// ToTime parses the timestamp in RFC3339 or Go default format
+func (t *Timestamp) ToTime() *time.Time {
+	if t == nil || t.Value == "" {
+		return nil
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999 -0700 MST"} {
+		if value, err := time.Parse(layout, t.Value); err == nil {
+			return &value
+		}
+	}
+
+	app.E().SetError(&system.Error{
+		Message: "Timestamp convertion error",
+	})
+	return nil
+}
+
 func Err(e *system.Error) *Error {
 	return &Error{
 		Code:    int32(e.Code),
@@ -3,6 +3,7 @@ package account
 import (
 	rep "chats/repository"
 	uuid "github.com/satori/go.uuid"
+	"time"
 )
 
 type Account struct {
@@ -17,6 +18,21 @@ type Account struct {
 	Email      string `gorm:"column:email"`
 	Phone      string `gorm:"column:phone"`
 	AvatarUrl  string `gorm:"column:avatar_url"`
+	// account the merged account was merged into
+	MergedInto *uuid.UUID `gorm:"column:merged_into"`
+	// personal data of the account has been erased at the time
+	ErasedAt   *time.Time `gorm:"column:erased_at"`
+	rep.BaseModel
+}
+
+// AccountDataRequest records export and erasure of the account's data
+type AccountDataRequest struct {
+	Id                 uuid.UUID
+	AccountId          uuid.UUID  `gorm:"column:account_id"`
+	// export | erasure
+	Type               string     `gorm:"column:type"`
+	InitiatorAccountId *uuid.UUID `gorm:"column:initiator_account_id"`
+	Reason             string     `gorm:"column:reason"`
 	rep.BaseModel
 }
 
@@ -6,8 +6,36 @@ import (
 	"encoding/json"
 	"github.com/go-redis/redis"
 	uuid "github.com/satori/go.uuid"
+	"time"
 )
 
+// SetSessionToken stores the token identifying the account's WebSocket connection (ttl 0 - the token doesn't expire)
+func (r *Repository) SetSessionToken(token string, accountId uuid.UUID, ttl time.Duration) *system.Error {
+
+	key := "session_token:" + token
+	err := r.Redis.Instance.Set(key, accountId.String(), ttl).Err()
+	if err != nil {
+		return system.SysErr(err, system.RedisSetErrorCode, nil)
+	}
+
+	return nil
+}
+
+// GetSessionTokenAccount retrieves the account the token is issued for, uuid.Nil if the token isn't found or expired
+func (r *Repository) GetSessionTokenAccount(token string) (uuid.UUID, *system.Error) {
+
+	key := "session_token:" + token
+	val, err := r.Redis.Instance.Get(key).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return uuid.Nil, nil
+		}
+		return uuid.Nil, system.SysErr(err, system.RedisGetErrorCode, nil)
+	}
+
+	return uuid.FromStringOrNil(val), nil
+}
+
 func (r *Repository) redisGetAccountModelById(id uuid.UUID) (*Account, *system.Error) {
 	uid := uuid.UUID.String(id)
 
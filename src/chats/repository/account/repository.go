@@ -57,6 +57,28 @@ func (s *Repository) UpdateAccount(accountModel *Account) *system.Error {
 	return nil
 }
 
+// SetAccountStatus changes the status of the account
+func (s *Repository) SetAccountStatus(account *Account, status string) *system.Error {
+
+	err := s.Storage.Instance.Exec(`
+		update accounts
+		set status = @status, updated_at = @now
+		where id = @id::uuid`,
+		map[string]interface{}{"id": account.Id, "status": status, "now": time.Now()}).Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	s.redisDeleteAccounts([]uuid.UUID{account.Id}, []string{account.ExternalId})
+
+	return nil
+}
+
+// ClearCache removes the accounts from the cache
+func (s *Repository) ClearCache(accountIds []uuid.UUID, externalIds []string) {
+	s.redisDeleteAccounts(accountIds, externalIds)
+}
+
 func (s *Repository) CreateOnlineStatus(onlineStatusModel *OnlineStatus) (uuid.UUID, *system.Error) {
 
 	result := s.Storage.Instance.Create(onlineStatusModel)
@@ -231,3 +253,32 @@ func (s *Repository) GetAccountsByCriteria(critera *GetAccountsCriteria) ([]Acco
 	return result, nil
 
 }
+
+func (s *Repository) CreateDataRequest(request *AccountDataRequest) *system.Error {
+
+	err := s.Storage.Instance.Create(request).Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	return nil
+}
+
+// EraseAccount wipes out personal data of the account
+// the login is often an email or a phone, so it's replaced with the account id
+func (s *Repository) EraseAccount(account *Account) *system.Error {
+
+	err := s.Storage.Instance.Exec(`
+		update accounts
+		set account = id::text, first_name = '', middle_name = '', last_name = '', email = '', phone = '', avatar_url = '',
+		    erased_at = @now, updated_at = @now
+		where id = @id::uuid`,
+		map[string]interface{}{"id": account.Id, "now": time.Now()}).Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	s.redisDeleteAccounts([]uuid.UUID{account.Id}, []string{account.ExternalId})
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package audit
+
+import (
+	rep "chats/repository"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type AuditLog struct {
+	Id             uuid.UUID
+	// account performed the action (empty if performed by the system or an external service)
+	ActorAccountId *uuid.UUID `gorm:"column:actor_account_id"`
+	Action         string     `gorm:"column:action"`
+	// room | account
+	TargetType     string     `gorm:"column:target_type"`
+	TargetId       *uuid.UUID `gorm:"column:target_id"`
+	// JSON state of the target before and after the action
+	Before         *string    `gorm:"column:before"`
+	After          *string    `gorm:"column:after"`
+	rep.BaseModel
+}
+
+func (AuditLog) TableName() string {
+	return "audit_log"
+}
+
+type GetAuditLogCriteria struct {
+	ActorAccountId uuid.UUID
+	Action         string
+	TargetType     string
+	TargetId       uuid.UUID
+	CreatedAfter   *time.Time
+	CreatedBefore  *time.Time
+}
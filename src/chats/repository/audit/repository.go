@@ -0,0 +1,109 @@
+package audit
+
+import (
+	"chats/app"
+	rep "chats/repository"
+	"chats/system"
+	uuid "github.com/satori/go.uuid"
+	"math"
+	"time"
+)
+
+type Repository struct {
+	Storage *app.Storage
+}
+
+func CreateRepository(storage *app.Storage) *Repository {
+	return &Repository{
+		Storage: storage,
+	}
+}
+
+func (r *Repository) CreateAuditLog(item *AuditLog) *system.Error {
+
+	err := r.Storage.Instance.Create(item).Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	return nil
+}
+
+// GetAuditLog retrieves the page of records matching the criteria (the latest first)
+func (r *Repository) GetAuditLog(criteria *GetAuditLogCriteria, pagingRequest *rep.PagingRequest) ([]AuditLog, *rep.PagingResponse, *system.Error) {
+
+	query := r.Storage.Instance.Model(&AuditLog{}).Where("deleted_at is null")
+
+	if criteria.ActorAccountId != uuid.Nil {
+		query = query.Where("actor_account_id = ?::uuid", criteria.ActorAccountId)
+	}
+
+	if criteria.Action != "" {
+		query = query.Where("action = ?", criteria.Action)
+	}
+
+	if criteria.TargetType != "" {
+		query = query.Where("target_type = ?", criteria.TargetType)
+	}
+
+	if criteria.TargetId != uuid.Nil {
+		query = query.Where("target_id = ?::uuid", criteria.TargetId)
+	}
+
+	if criteria.CreatedAfter != nil {
+		query = query.Where("created_at >= ?", criteria.CreatedAfter)
+	}
+
+	if criteria.CreatedBefore != nil {
+		query = query.Where("created_at <= ?", criteria.CreatedBefore)
+	}
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, nil, system.E(err)
+	}
+
+	pagingResponse := &rep.PagingResponse{
+		Total: int(math.Ceil(float64(totalCount) / float64(pagingRequest.Size))),
+		Index: pagingRequest.Index,
+	}
+
+	var items []AuditLog
+	err := query.
+		Order("created_at desc").
+		Offset((pagingRequest.Index - 1) * pagingRequest.Size).
+		Limit(pagingRequest.Size).
+		Find(&items).Error
+	if err != nil {
+		return nil, nil, system.E(err)
+	}
+
+	return items, pagingResponse, nil
+}
+
+// EraseTargetStates removes the states of the target, so personal data isn't kept after erasure
+func (r *Repository) EraseTargetStates(targetType string, targetId uuid.UUID) *system.Error {
+
+	err := r.Storage.Instance.Exec(`
+		update audit_log
+		set before = null, after = null
+		where target_type = ? and target_id = ?::uuid`, targetType, targetId).Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	return nil
+}
+
+// PurgeAuditLog hard deletes a batch of records created before the time, returns the number of deleted records
+func (r *Repository) PurgeAuditLog(before time.Time, limit int) (int64, *system.Error) {
+
+	result := r.Storage.Instance.Exec(`
+		delete from audit_log
+		where id in (select id from audit_log where created_at < ? limit ?)`, before, limit)
+	if result.Error != nil {
+		return 0, system.E(result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
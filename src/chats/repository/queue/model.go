@@ -0,0 +1,37 @@
+package queue
+
+import (
+	rep "chats/repository"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+type QueueOperator struct {
+	Id        uuid.UUID
+	Queue     string    `gorm:"column:queue"`
+	AccountId uuid.UUID `gorm:"column:account_id"`
+	Available uint8     `gorm:"column:available"`
+	rep.BaseModel
+}
+
+type QueueItem struct {
+	Id                uuid.UUID
+	Queue             string     `gorm:"column:queue"`
+	RoomId            uuid.UUID  `gorm:"column:room_id"`
+	AssignedAccountId *uuid.UUID `gorm:"column:assigned_account_id"`
+	AssignedAt        *time.Time `gorm:"column:assigned_at"`
+	Transfers         int        `gorm:"column:transfers"`
+	rep.BaseModel
+}
+
+type OperatorLoad struct {
+	AccountId uuid.UUID `gorm:"column:account_id"`
+	// number of open rooms the operator is subscribed on
+	Rooms          int        `gorm:"column:rooms"`
+	LastAssignedAt *time.Time `gorm:"column:last_assigned_at"`
+}
+
+type GetQueueItemsCriteria struct {
+	Queue        string
+	WithAssigned bool
+}
@@ -0,0 +1,172 @@
+package queue
+
+import (
+	"chats/app"
+	"chats/system"
+	uuid "github.com/satori/go.uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+type Repository struct {
+	Storage *app.Storage
+}
+
+func CreateRepository(storage *app.Storage) *Repository {
+	return &Repository{
+		Storage: storage,
+	}
+}
+
+func (r *Repository) SetOperatorAvailability(queue string, accountId uuid.UUID, available bool) *system.Error {
+
+	t := time.Now()
+
+	err := r.Storage.Instance.Exec(`
+		insert into queue_operators(id, queue, account_id, available, created_at, updated_at)
+			values (?, ?, ?, ?, ?, ?)
+		on conflict (queue, account_id) do update
+			set available = excluded.available,
+			    updated_at = excluded.updated_at
+		`, system.Uuid(), queue, accountId, system.BoolToUint8(available), t, t).Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateItem(item *QueueItem) *system.Error {
+
+	result := r.Storage.Instance.Create(item)
+	if result.Error != nil {
+		return &system.Error{Error: result.Error}
+	}
+
+	return nil
+}
+
+// GetWaitingQueues retrieves queues having not assigned items of open rooms
+func (r *Repository) GetWaitingQueues() ([]string, *system.Error) {
+
+	var result []string
+
+	err := r.Storage.Instance.Raw(`
+		select distinct qi.queue
+			from queue_items qi
+				join rooms r on r.id = qi.room_id
+			where qi.assigned_account_id is null and
+				  qi.deleted_at is null and
+				  r.closed_at is null
+		`).Scan(&result).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	return result, nil
+}
+
+// GetItems retrieves items of open rooms ordered by the time they have been queued
+func (r *Repository) GetItems(criteria *GetQueueItemsCriteria) ([]QueueItem, *system.Error) {
+
+	var result []QueueItem
+
+	q := r.Storage.Instance.
+		Table("queue_items qi").
+		Select("qi.*").
+		Joins("join rooms r on r.id = qi.room_id").
+		Where("qi.deleted_at is null").
+		Where("r.closed_at is null")
+
+	if criteria.Queue != "" {
+		q = q.Where("qi.queue = ?", criteria.Queue)
+	}
+
+	if !criteria.WithAssigned {
+		q = q.Where("qi.assigned_account_id is null")
+	}
+
+	err := q.Order("qi.created_at").Scan(&result).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	return result, nil
+}
+
+// GetOperatorsLoad retrieves active operators available for the queue along with their current load
+func (r *Repository) GetOperatorsLoad(queue string) ([]OperatorLoad, *system.Error) {
+
+	var result []OperatorLoad
+
+	err := r.Storage.Instance.Raw(`
+		select qo.account_id,
+			   (select count(*)
+					from room_subscribers rs
+						join rooms r on r.id = rs.room_id
+					where rs.account_id = qo.account_id and
+						  rs.unsubscribe_at is null and
+						  rs.observer = 0 and
+						  r.closed_at is null) as rooms,
+			   (select max(qi.assigned_at)
+					from queue_items qi
+					where qi.assigned_account_id = qo.account_id) as last_assigned_at
+			from queue_operators qo
+				join accounts a on a.id = qo.account_id
+			where qo.queue = ? and
+				  qo.available = 1 and
+				  qo.deleted_at is null and
+				  a.status = 'active'
+		`, queue).Scan(&result).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	return result, nil
+}
+
+// AssignItem assigns the item to the operator if it hasn't been assigned yet
+// returns false if the item has been already taken
+func (r *Repository) AssignItem(itemId uuid.UUID, accountId uuid.UUID) (bool, *system.Error) {
+
+	t := time.Now()
+
+	result := r.Storage.Instance.Model(&QueueItem{}).
+		Where("id = ?::uuid", itemId).
+		Where("assigned_account_id is null").
+		Updates(map[string]interface{}{"assigned_account_id": accountId, "assigned_at": t, "updated_at": t})
+	if result.Error != nil {
+		return false, system.E(result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// TransferItem reassigns the room's item to the account and counts the transfer
+func (r *Repository) TransferItem(roomId uuid.UUID, accountId uuid.UUID) *system.Error {
+
+	err := r.Storage.Instance.Model(&QueueItem{}).
+		Where("room_id = ?::uuid", roomId).
+		Updates(map[string]interface{}{
+			"assigned_account_id": accountId,
+			"transfers":           gorm.Expr("transfers + 1"),
+			"updated_at":          time.Now(),
+		}).Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) UnassignItem(itemId uuid.UUID) *system.Error {
+
+	err := r.Storage.Instance.Model(&QueueItem{}).
+		Where("id = ?::uuid", itemId).
+		Updates(map[string]interface{}{"assigned_account_id": nil, "assigned_at": nil, "updated_at": time.Now()}).Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package role
+
+import (
+	rep "chats/repository"
+	uuid "github.com/satori/go.uuid"
+)
+
+type SubscriberRole struct {
+	Code        string `gorm:"column:code;primaryKey"`
+	Description string `gorm:"column:description"`
+	// capabilities granted to the role
+	Capabilities []string `gorm:"-"`
+	rep.BaseModel
+}
+
+type RoleCapability struct {
+	Id         uuid.UUID
+	Role       string `gorm:"column:role"`
+	Capability string `gorm:"column:capability"`
+	rep.BaseModel
+}
@@ -0,0 +1,52 @@
+package role
+
+import (
+	"chats/app"
+	"chats/system"
+	"encoding/json"
+	"github.com/go-redis/redis"
+)
+
+const rolesKey = "subscriber_roles"
+
+func (r *Repository) redisGetRoles() ([]SubscriberRole, *system.Error) {
+
+	val, err := r.Redis.Instance.Get(rolesKey).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			return nil, app.E().SetError(system.SysErr(err, system.RedisGetErrorCode, nil))
+		}
+	}
+
+	if val == nil {
+		return nil, nil
+	}
+
+	var roles []SubscriberRole
+	err = json.Unmarshal(val, &roles)
+	if err != nil {
+		return nil, app.E().SetError(system.SysErr(err, system.UnmarshallingErrorCode, val))
+	}
+	app.L().Debugf("Roles found in redis: %s", rolesKey)
+
+	return roles, nil
+}
+
+func (r *Repository) redisSetRoles(roles []SubscriberRole) *system.Error {
+
+	marshal, _ := json.Marshal(roles)
+
+	err := r.Redis.Instance.Set(rolesKey, marshal, r.Redis.Ttl).Err()
+	if err != nil {
+		return app.E().SetError(system.SysErr(err, system.RedisSetErrorCode, marshal))
+	}
+	app.L().Debugf("Roles set in redis: %s", rolesKey)
+
+	return nil
+}
+
+func (r *Repository) redisDeleteRoles() *system.Error {
+	r.Redis.Instance.Del(rolesKey)
+	app.L().Debugf("Roles delete in redis: %s", rolesKey)
+	return nil
+}
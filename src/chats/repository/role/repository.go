@@ -0,0 +1,130 @@
+package role
+
+import (
+	"chats/app"
+	"chats/system"
+	"time"
+)
+
+type Repository struct {
+	Storage *app.Storage
+	Redis   *app.Redis
+}
+
+func CreateRepository(storage *app.Storage) *Repository {
+	return &Repository{
+		Storage: storage,
+		Redis:   storage.Redis,
+	}
+}
+
+// GetRoles retrieves all the roles along with their capabilities
+func (r *Repository) GetRoles() ([]SubscriberRole, *system.Error) {
+
+	roles, err := r.redisGetRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	if roles != nil {
+		return roles, nil
+	}
+
+	roles = []SubscriberRole{}
+	e := r.Storage.Instance.
+		Where("deleted_at is null").
+		Order("code").
+		Find(&roles).Error
+	if e != nil {
+		return nil, system.E(e)
+	}
+
+	var capabilities []RoleCapability
+	e = r.Storage.Instance.
+		Where("deleted_at is null").
+		Order("capability").
+		Find(&capabilities).Error
+	if e != nil {
+		return nil, system.E(e)
+	}
+
+	for i := range roles {
+		roles[i].Capabilities = []string{}
+		for _, c := range capabilities {
+			if c.Role == roles[i].Code {
+				roles[i].Capabilities = append(roles[i].Capabilities, c.Capability)
+			}
+		}
+	}
+
+	r.redisSetRoles(roles)
+
+	return roles, nil
+}
+
+// SetRole creates the role or replaces the description and capabilities of the existent one
+func (r *Repository) SetRole(role *SubscriberRole) *system.Error {
+
+	t := time.Now()
+
+	tx := r.Storage.Instance.Begin()
+
+	err := tx.Exec(`
+		insert into subscriber_roles(code, description, created_at, updated_at)
+			values (?, ?, ?, ?)
+		on conflict (code) do update
+			set description = excluded.description,
+				updated_at = excluded.updated_at,
+				deleted_at = null
+		`, role.Code, role.Description, t, t).Error
+	if err != nil {
+		tx.Rollback()
+		return system.E(err)
+	}
+
+	err = tx.Exec(`delete from role_capabilities where role = ?`, role.Code).Error
+	if err != nil {
+		tx.Rollback()
+		return system.E(err)
+	}
+
+	for _, c := range role.Capabilities {
+		err = tx.Create(&RoleCapability{
+			Id:         system.Uuid(),
+			Role:       role.Code,
+			Capability: c,
+		}).Error
+		if err != nil {
+			tx.Rollback()
+			return system.E(err)
+		}
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	r.redisDeleteRoles()
+
+	return nil
+}
+
+// DeleteRole marks the role as deleted, subscribers with the role lose all the capabilities
+// returns false if the role isn't found
+func (r *Repository) DeleteRole(code string) (bool, *system.Error) {
+
+	t := time.Now()
+
+	result := r.Storage.Instance.Model(&SubscriberRole{}).
+		Where("code = ?", code).
+		Where("deleted_at is null").
+		Updates(map[string]interface{}{"deleted_at": t, "updated_at": t})
+	if result.Error != nil {
+		return false, system.E(result.Error)
+	}
+
+	r.redisDeleteRoles()
+
+	return result.RowsAffected > 0, nil
+}
@@ -12,6 +12,8 @@ type RoomSubscriber struct {
 	AccountId     uuid.UUID  `gorm:"column:account_id"`
 	Role          string     `gorm:"column:role"`
 	SystemAccount uint8      `gorm:"column:system_account"`
+	// observers silently watch the room: they aren't visible to other subscribers and don't get message statuses
+	Observer      uint8      `gorm:"column:observer"`
 	UnsubscribeAt *time.Time `gorm:"column:unsubscribe_at"`
 	rep.BaseModel
 }
@@ -20,10 +22,34 @@ type Room struct {
 	Id          uuid.UUID
 	ReferenceId string     `gorm:"column:reference_id"`
 	Hash        string     `gorm:"column:hash"`
+	// join by the hash isn't allowed after the time (if populated)
+	HashExpiresAt *time.Time `gorm:"column:hash_expires_at"`
+	// the hash can be used to join only once
+	HashOneTime uint8      `gorm:"column:hash_one_time"`
+	HashUsedAt  *time.Time `gorm:"column:hash_used_at"`
 	Chat        uint8      `gorm:"column:chat"`
 	Audio       uint8      `gorm:"column:audio"`
 	Video       uint8      `gorm:"column:video"`
 	ClosedAt    *time.Time `gorm:"column:closed_at"`
+	Queue       string     `gorm:"column:queue"`
+	Type        string     `gorm:"column:type"`
+	// the room is closed if there are no messages during the timeout (seconds)
+	InactivityTimeout int64 `gorm:"column:inactivity_timeout"`
+	// the room is closed at the time (if populated)
+	CloseAt       *time.Time `gorm:"column:close_at"`
+	CloseWarnedAt *time.Time `gorm:"column:close_warned_at"`
+	ReopenedAt    *time.Time `gorm:"column:reopened_at"`
+	// archived rooms are hidden from search by default
+	ArchivedAt    *time.Time `gorm:"column:archived_at"`
+	Title         string     `gorm:"column:title"`
+	Description   string     `gorm:"column:description"`
+	AvatarUrl     string     `gorm:"column:avatar_url"`
+	// arbitrary JSON object
+	Attributes    *string    `gorm:"column:attributes"`
+	// JSON array of strings
+	Tags          *string    `gorm:"column:tags"`
+	// messages expire after the ttl (seconds) unless the message's own ttl is specified
+	MessageTtl    int64      `gorm:"column:message_ttl"`
 	Subscribers []RoomSubscriber
 	rep.BaseModel
 }
@@ -34,7 +60,52 @@ type GetRoomCriteria struct {
 	ReferenceId       string
 	RoomId            uuid.UUID
 	WithClosed        bool
+	WithArchived      bool
 	WithSubscribers   bool
+	// JSON array of tags the room must have all of
+	Tags              *string
+}
+
+type AccountRoomsCursor struct {
+	ActivityAt time.Time
+	RoomId     uuid.UUID
+}
+
+type GetAccountRoomsCriteria struct {
+	AccountId  uuid.UUID
+	WithClosed bool
+	// rooms following the cursor are returned
+	Before     *AccountRoomsCursor
+	Limit      int
+}
+
+type AccountRoom struct {
+	Id                   uuid.UUID
+	ReferenceId          string     `gorm:"column:reference_id"`
+	Type                 string     `gorm:"column:type"`
+	Title                string     `gorm:"column:title"`
+	AvatarUrl            string     `gorm:"column:avatar_url"`
+	ClosedAt             *time.Time `gorm:"column:closed_at"`
+	LastActivityAt       time.Time  `gorm:"column:last_activity_at"`
+	LastMessageId        *uuid.UUID `gorm:"column:last_message_id"`
+	LastMessageType      *string    `gorm:"column:last_message_type"`
+	LastMessageText      *string    `gorm:"column:last_message_text"`
+	LastMessageAccountId *uuid.UUID `gorm:"column:last_message_account_id"`
+	LastMessageAt        *time.Time `gorm:"column:last_message_at"`
+	LastMessageExpired   bool       `gorm:"column:last_message_expired"`
+	LastMessageDeleted   bool       `gorm:"column:last_message_deleted"`
+	UnreadCount          int64      `gorm:"column:unread_count"`
+}
+
+type RoomToClose struct {
+	Id      uuid.UUID
+	CloseAt time.Time
+	// the room must be closed right now
+	Due bool
+	// the closing warning must be sent
+	Warn bool
+	// closed by the schedule rather than inactivity
+	Scheduled bool
 }
 
 type AccountSubscriber struct {
@@ -43,6 +114,7 @@ type AccountSubscriber struct {
 	SubscriberId uuid.UUID
 	Role         string
 	SystemAccount uint8
+	Observer     uint8
 }
 
 type ChatOpponent struct {
@@ -61,10 +133,56 @@ type ChatMessage struct {
 	Message            string     `gorm:"column:message"`
 	FileId             string     `gorm:"column:file_id"`
 	Params             string     `gorm:"column:params"`
+	Payload            *string    `gorm:"column:payload"`
 	RecipientAccountId *uuid.UUID `gorm:"column:recipient_account_id"`
+	// all | recipient | roles
+	Visibility         string     `gorm:"column:visibility;default:all"`
+	// json array of roles the message is visible to (roles visibility)
+	VisibleRoles       *string    `gorm:"column:visible_roles"`
+	// the original message (populated if the message is forwarded)
+	ForwardedMessageId *uuid.UUID `gorm:"column:forwarded_message_id"`
+	ForwardedRoomId    *uuid.UUID `gorm:"column:forwarded_room_id"`
+	ForwardedAccountId *uuid.UUID `gorm:"column:forwarded_account_id"`
+	ForwardedAt        *time.Time `gorm:"column:forwarded_at"`
+	// the message content is wiped out after the time (if populated)
+	ExpiresAt          *time.Time `gorm:"column:expires_at"`
+	ExpiredAt          *time.Time `gorm:"column:expired_at"`
+	// the message content has been wiped out by a moderator at the time
+	ModeratedAt        *time.Time `gorm:"column:moderated_at"`
 	rep.BaseModel
 }
 
+// ExpiredMessage is the tombstone left after the message content is wiped out
+type ExpiredMessage struct {
+	Id                 uuid.UUID  `gorm:"column:id"`
+	RoomId             uuid.UUID  `gorm:"column:room_id"`
+	AccountId          uuid.UUID  `gorm:"column:account_id"`
+	RecipientAccountId *uuid.UUID `gorm:"column:recipient_account_id"`
+	Visibility         string     `gorm:"column:visibility"`
+	VisibleRoles       *string    `gorm:"column:visible_roles"`
+	// the message has been unpinned on wiping out
+	Pinned bool `gorm:"-"`
+}
+
+// AccountSubscription is the account's membership in the room
+type AccountSubscription struct {
+	RoomId        uuid.UUID  `gorm:"column:room_id"`
+	ReferenceId   string     `gorm:"column:reference_id"`
+	RoomType      string     `gorm:"column:room_type"`
+	Role          string     `gorm:"column:role"`
+	Observer      uint8      `gorm:"column:observer"`
+	SubscribedAt  time.Time  `gorm:"column:subscribed_at"`
+	UnsubscribeAt *time.Time `gorm:"column:unsubscribe_at"`
+}
+
+// RetentionRoomFilter specifies rooms which data is purged by the retention policy
+type RetentionRoomFilter struct {
+	RoomType        string
+	ReferencePrefix string
+	// rooms matching any of the filters are excluded
+	Except          []RetentionRoomFilter
+}
+
 type ChatMessageStatus struct {
 	Id          uuid.UUID
 	MessageId   uuid.UUID `gorm:"column:message_id"`
@@ -74,6 +192,75 @@ type ChatMessageStatus struct {
 	rep.BaseModel
 }
 
+type RoomPinnedMessage struct {
+	Id        uuid.UUID
+	RoomId    uuid.UUID `gorm:"column:room_id"`
+	MessageId uuid.UUID `gorm:"column:message_id"`
+	// account pinned the message
+	AccountId uuid.UUID `gorm:"column:account_id"`
+	rep.BaseModel
+}
+
+type ChatMessageStar struct {
+	Id        uuid.UUID
+	MessageId uuid.UUID `gorm:"column:message_id"`
+	AccountId uuid.UUID `gorm:"column:account_id"`
+	rep.BaseModel
+}
+
+type PinnedMessage struct {
+	RoomId           uuid.UUID `gorm:"column:room_id"`
+	MessageId        uuid.UUID `gorm:"column:message_id"`
+	PinnedBy         uuid.UUID `gorm:"column:pinned_by"`
+	PinnedAt         time.Time `gorm:"column:pinned_at"`
+	Type             string    `gorm:"column:type"`
+	Message          string    `gorm:"column:message"`
+	SenderAccountId  uuid.UUID `gorm:"column:sender_account_id"`
+	MessageCreatedAt time.Time `gorm:"column:message_created_at"`
+}
+
+type ScheduledMessage struct {
+	Id        uuid.UUID
+	RoomId    uuid.UUID `gorm:"column:room_id"`
+	// sender
+	AccountId uuid.UUID `gorm:"column:account_id"`
+	SendAt    time.Time `gorm:"column:send_at"`
+	// the message request as JSON
+	Message   string    `gorm:"column:message"`
+	rep.BaseModel
+}
+
+// HeldMessage is a message held by the moderation until it's reviewed
+type HeldMessage struct {
+	Id        uuid.UUID
+	RoomId    uuid.UUID `gorm:"column:room_id"`
+	// sender
+	AccountId uuid.UUID `gorm:"column:account_id"`
+	// the message request as JSON
+	Message   string    `gorm:"column:message"`
+	// moderation filter held the message
+	Filter    string    `gorm:"column:filter"`
+	Reason    string    `gorm:"column:reason"`
+	rep.BaseModel
+}
+
+// MessageReport is a complaint of the account about the message
+type MessageReport struct {
+	Id         uuid.UUID
+	MessageId  uuid.UUID  `gorm:"column:message_id"`
+	RoomId     uuid.UUID  `gorm:"column:room_id"`
+	// reporter
+	AccountId  uuid.UUID  `gorm:"column:account_id"`
+	Reason     string     `gorm:"column:reason"`
+	// open | dismissed | deleted | locked
+	Status     string     `gorm:"column:status"`
+	// moderator resolved the report (empty if resolved by an external service)
+	ResolvedBy *uuid.UUID `gorm:"column:resolved_by"`
+	ResolvedAt *time.Time `gorm:"column:resolved_at"`
+	Comment    string     `gorm:"column:comment"`
+	rep.BaseModel
+}
+
 type GetMessageHistoryCriteria struct {
 	AccountId         uuid.UUID
 	AccountExternalId string
@@ -86,6 +273,8 @@ type GetMessageHistoryCriteria struct {
 	SentOnly          bool
 	ReceivedOnly      bool
 	WithAccounts      bool
+	// messages starred by the account only (works together with AccountId)
+	StarredOnly       bool
 }
 
 type MessageStatus struct {
@@ -113,11 +302,22 @@ type MessageHistoryItem struct {
 	ClientMessageId    string
 	ReferenceId        string
 	RoomId             uuid.UUID
+	RoomType           string
 	Type               string
 	Message            string
 	FileId             string
 	Params             map[string]string
+	Payload            string
 	SenderAccountId    uuid.UUID
 	RecipientAccountId *uuid.UUID
+	Visibility         string
+	VisibleRoles       *string
+	ForwardedMessageId *uuid.UUID
+	ForwardedRoomId    *uuid.UUID
+	ForwardedAccountId *uuid.UUID
+	ForwardedAt        *time.Time
+	ExpiresAt          *time.Time
+	ExpiredAt          *time.Time
+	ModeratedAt        *time.Time
 	Statuses           []MessageStatus
 }
@@ -18,15 +18,16 @@ func (r *Repository) redisGetRoom(roomId uuid.UUID) (*Room, *system.Error) {
 		}
 	}
 
+	if val == nil {
+		return nil, nil
+	}
+
 	room := &Room{}
-	if val != nil {
-		err = json.Unmarshal(val, room)
-		if err != nil {
-			room.Id = uuid.Nil
-			return nil, app.E().SetError(system.SysErr(err, system.UnmarshallingErrorCode, val))
-		}
-		app.L().Debugf("Room found in redis: %s", key)
+	err = json.Unmarshal(val, room)
+	if err != nil {
+		return nil, app.E().SetError(system.SysErr(err, system.UnmarshallingErrorCode, val))
 	}
+	app.L().Debugf("Room found in redis: %s", key)
 
 	return room, nil
 }
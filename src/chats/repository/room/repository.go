@@ -8,6 +8,7 @@ import (
 	"fmt"
 	uuid "github.com/satori/go.uuid"
 	"math"
+	"strings"
 	"time"
 )
 
@@ -71,131 +72,1318 @@ func (r *Repository) RoomUnsubscribeAccount(roomId, accountId uuid.UUID) *system
 
 }
 
+// TransferSubscriber unsubscribes one account and subscribes another one within a transaction
+// if the new account has been subscribed on the room before, its subscription is reactivated
+func (r *Repository) TransferSubscriber(roomId uuid.UUID, fromAccountId uuid.UUID, to *RoomSubscriber) *system.Error {
+
+	t := time.Now()
+
+	tx := r.Storage.Instance.Begin()
+
+	result := tx.Model(&RoomSubscriber{}).
+		Where("room_id = ?::uuid", roomId).
+		Where("account_id = ?::uuid", fromAccountId).
+		Where("unsubscribe_at is null").
+		Updates(map[string]interface{}{"unsubscribe_at": t, "updated_at": t})
+	if result.Error != nil {
+		tx.Rollback()
+		return system.E(result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return system.SysErrf(nil, system.NotSubscribedAccountCode, nil, fromAccountId.String(), roomId.String())
+	}
+
+	err := tx.Raw(`
+		insert into room_subscribers(id, room_id, account_id, role, system_account, created_at, updated_at)
+			values (?, ?, ?, ?, ?, ?, ?)
+		on conflict (room_id, account_id) do update
+			set role = excluded.role,
+				system_account = excluded.system_account,
+				observer = 0,
+				unsubscribe_at = null,
+				updated_at = excluded.updated_at
+		returning id
+		`, to.Id, roomId, to.AccountId, to.Role, to.SystemAccount, t, t).Row().Scan(&to.Id)
+	if err != nil {
+		tx.Rollback()
+		return system.E(err)
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	r.redisDeleteRooms([]uuid.UUID{roomId})
+
+	return nil
+}
+
+// PromoteObserver turns the observer into a regular subscriber with the given role
+// returns false if the account isn't an observer of the room
+func (r *Repository) PromoteObserver(roomId uuid.UUID, accountId uuid.UUID, role string) (bool, *system.Error) {
+
+	result := r.Storage.Instance.Model(&RoomSubscriber{}).
+		Where("room_id = ?::uuid", roomId).
+		Where("account_id = ?::uuid", accountId).
+		Where("observer = 1").
+		Where("unsubscribe_at is null").
+		Updates(map[string]interface{}{"observer": 0, "role": role, "updated_at": time.Now()})
+	if result.Error != nil {
+		return false, system.E(result.Error)
+	}
+
+	r.redisDeleteRooms([]uuid.UUID{roomId})
+
+	return result.RowsAffected > 0, nil
+}
+
+// MergeAccounts moves subscriptions, messages and statuses of the account to another account and marks the account merged
+// if both accounts are subscribed to the same room, the target subscription is kept
+// returns the rooms affected
+func (r *Repository) MergeAccounts(fromAccountId uuid.UUID, toAccountId uuid.UUID) ([]uuid.UUID, *system.Error) {
+
+	var roomIds []uuid.UUID
+
+	tx := r.Storage.Instance.Begin()
+
+	rows, err := tx.Raw(`select distinct room_id from room_subscribers where account_id = ?`, fromAccountId).Rows()
+	if err != nil {
+		tx.Rollback()
+		return nil, system.E(err)
+	}
+	for rows.Next() {
+		var roomId uuid.UUID
+		if err := rows.Scan(&roomId); err != nil {
+			rows.Close()
+			tx.Rollback()
+			return nil, system.E(err)
+		}
+		roomIds = append(roomIds, roomId)
+	}
+	rows.Close()
+
+	statements := []string{
+		// statuses already existing for the target subscriber are dropped
+		`delete from chat_message_statuses s
+			using room_subscribers f, room_subscribers t
+		where s.subscribe_id = f.id and f.account_id = @from
+		  and t.room_id = f.room_id and t.account_id = @to
+		  and exists(select 1 from chat_message_statuses ts where ts.message_id = s.message_id and ts.subscribe_id = t.id)`,
+		`update chat_message_statuses s set subscribe_id = t.id
+			from room_subscribers f, room_subscribers t
+		where s.subscribe_id = f.id and f.account_id = @from
+		  and t.room_id = f.room_id and t.account_id = @to`,
+		`update chat_messages m set subscribe_id = t.id
+			from room_subscribers f, room_subscribers t
+		where m.subscribe_id = f.id and f.account_id = @from
+		  and t.room_id = f.room_id and t.account_id = @to`,
+		// the target subscription stays active if any of the subscriptions is active
+		`update room_subscribers t
+			set unsubscribe_at = case when f.unsubscribe_at is null then null else t.unsubscribe_at end,
+				observer = least(t.observer, f.observer),
+				updated_at = now()
+			from room_subscribers f
+		where f.account_id = @from and t.room_id = f.room_id and t.account_id = @to`,
+		`delete from room_subscribers f
+			using room_subscribers t
+		where f.account_id = @from and t.room_id = f.room_id and t.account_id = @to`,
+		`update room_subscribers set account_id = @to, updated_at = now() where account_id = @from`,
+		`update chat_messages set account_id = @to, updated_at = now() where account_id = @from`,
+		`update chat_messages set recipient_account_id = @to, updated_at = now() where recipient_account_id = @from`,
+		`update chat_messages set forwarded_account_id = @to where forwarded_account_id = @from`,
+		`update scheduled_messages set account_id = @to where account_id = @from`,
+		`update held_messages set account_id = @to where account_id = @from`,
+		`delete from message_reports f
+			using message_reports t
+		where f.account_id = @from and t.account_id = @to and t.message_id = f.message_id and f.status = 'open' and t.status = 'open'`,
+		`update message_reports set account_id = @to, updated_at = now() where account_id = @from`,
+		`update message_reports set resolved_by = @to where resolved_by = @from`,
+		`update chat_message_statuses set account_id = @to, updated_at = now() where account_id = @from`,
+		`delete from chat_message_stars f
+			using chat_message_stars t
+		where f.account_id = @from and t.account_id = @to and t.message_id = f.message_id`,
+		`update chat_message_stars set account_id = @to, updated_at = now() where account_id = @from`,
+		`update room_pinned_messages set account_id = @to, updated_at = now() where account_id = @from`,
+		`update accounts set status = 'merged', merged_into = @to, updated_at = now() where id = @from`,
+	}
+
+	params := map[string]interface{}{"from": fromAccountId, "to": toAccountId}
+	for _, stmt := range statements {
+		if err := tx.Exec(stmt, params).Error; err != nil {
+			tx.Rollback()
+			return nil, system.E(err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, system.E(err)
+	}
+
+	r.redisDeleteRooms(roomIds)
+
+	return roomIds, nil
+}
+
+// GetRoomByHash retrieves the room by its hash, nil if not found
+func (r *Repository) GetRoomByHash(hash string) (*Room, *system.Error) {
+
+	room := &Room{}
+
+	err := r.Storage.Instance.
+		Where("hash = ?", hash).
+		Where("deleted_at is null").
+		Limit(1).
+		Find(room).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	if room.Id == uuid.Nil {
+		return nil, nil
+	}
+
+	return room, nil
+}
+
+// UseRoomHash marks the one-time hash of the room as used
+// returns false if the hash has been already used
+func (r *Repository) UseRoomHash(roomId uuid.UUID) (bool, *system.Error) {
+
+	t := time.Now()
+
+	result := r.Storage.Instance.Model(&Room{}).
+		Where("id = ?::uuid", roomId).
+		Where("hash_used_at is null").
+		Updates(map[string]interface{}{"hash_used_at": t, "updated_at": t})
+	if result.Error != nil {
+		return false, system.E(result.Error)
+	}
+
+	r.redisDeleteRooms([]uuid.UUID{roomId})
+
+	return result.RowsAffected > 0, nil
+}
+
+// ReleaseRoomHash makes the one-time link usable again
+func (r *Repository) ReleaseRoomHash(roomId uuid.UUID) *system.Error {
+
+	err := r.Storage.Instance.Model(&Room{}).
+		Where("id = ?::uuid", roomId).
+		Updates(map[string]interface{}{"hash_used_at": nil, "updated_at": time.Now()}).Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	r.redisDeleteRooms([]uuid.UUID{roomId})
+
+	return nil
+}
+
 func (r *Repository) GetRoom(id uuid.UUID) (*Room, *system.Error) {
 
-	room := &Room{}
+	room, err := r.redisGetRoom(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if room != nil {
+		return room, nil
+	} else {
+
+		room = &Room{}
+		err := r.Storage.Instance.
+			Preload("Subscribers").
+			First(room, id).Error
+
+		if err != nil {
+			return nil, system.E(err)
+		}
+
+		r.redisSetRoom(room)
+
+		return room, nil
+	}
+}
+
+func (r *Repository) CloseRoom(roomId uuid.UUID) *system.Error {
+	roomModel := &Room{}
+
+	t := time.Now()
+	roomModel.ClosedAt = &t
+	roomModel.UpdatedAt = t
+
+	r.Storage.Instance.Model(roomModel).
+		Where("id = ?::uuid", roomId).
+		Updates(map[string]interface{}{"closed_at": roomModel.ClosedAt, "updated_at": roomModel.UpdatedAt})
+	r.redisDeleteRooms([]uuid.UUID{roomId})
+
+	return nil
+}
+
+// GetRoomsToClose retrieves open rooms which must be closed before the warning time
+// a room is closed at the scheduled time or when there are no messages (except system ones) during its inactivity timeout
+func (r *Repository) GetRoomsToClose(now time.Time, warning time.Duration) ([]RoomToClose, *system.Error) {
+
+	var items []RoomToClose
+
+	err := r.Storage.Instance.Raw(`
+		select id, deadline as close_at, deadline <= @now as due,
+		       (close_warned_at is null or close_warned_at < last_activity) as warn,
+		       (close_at is not null and deadline = close_at) as scheduled
+		from (
+			select r.id, r.close_at, r.close_warned_at, la.last_activity,
+			       least(r.close_at,
+			             case when r.inactivity_timeout > 0 then la.last_activity + r.inactivity_timeout * interval '1 second' end) as deadline
+			from rooms r,
+				lateral (select greatest(coalesce(max(cm.created_at), r.created_at), r.reopened_at) as last_activity
+				         from chat_messages cm
+				         where cm.room_id = r.id and cm.type <> 'system' and cm.deleted_at is null) la
+			where r.closed_at is null
+			  and r.deleted_at is null
+			  and (r.close_at is not null or r.inactivity_timeout > 0)
+		) t
+		where deadline <= @warnAt
+		`, map[string]interface{}{"now": now, "warnAt": now.Add(warning)}).Scan(&items).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	return items, nil
+}
+
+// SetRoomCloseWarned marks the closing warning sent
+func (r *Repository) SetRoomCloseWarned(roomId uuid.UUID) *system.Error {
+
+	t := time.Now()
+
+	err := r.Storage.Instance.Model(&Room{}).
+		Where("id = ?::uuid", roomId).
+		Updates(map[string]interface{}{"close_warned_at": t, "updated_at": t}).Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	r.redisDeleteRooms([]uuid.UUID{roomId})
+
+	return nil
+}
+
+// UpdateRoomMetadata updates the room's title, description, avatar, attributes and tags
+func (r *Repository) UpdateRoomMetadata(room *Room) *system.Error {
+
+	err := r.Storage.Instance.Model(&Room{}).
+		Where("id = ?::uuid", room.Id).
+		Updates(map[string]interface{}{
+			"title":       room.Title,
+			"description": room.Description,
+			"avatar_url":  room.AvatarUrl,
+			"attributes":  room.Attributes,
+			"tags":        room.Tags,
+			"updated_at":  time.Now(),
+		}).Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	r.redisDeleteRooms([]uuid.UUID{room.Id})
+
+	return nil
+}
+
+// ReopenRoom opens the closed room again, returns false if the room isn't closed or archived
+func (r *Repository) ReopenRoom(roomId uuid.UUID) (bool, *system.Error) {
+
+	t := time.Now()
+
+	result := r.Storage.Instance.Model(&Room{}).
+		Where("id = ?::uuid", roomId).
+		Where("closed_at is not null").
+		Where("archived_at is null").
+		Updates(map[string]interface{}{
+			"closed_at":       nil,
+			"close_at":        nil,
+			"close_warned_at": nil,
+			"reopened_at":     t,
+			"updated_at":      t,
+		})
+	if result.Error != nil {
+		return false, system.E(result.Error)
+	}
+
+	r.redisDeleteRooms([]uuid.UUID{roomId})
+
+	return result.RowsAffected > 0, nil
+}
+
+// ArchiveRoom archives the closed room, returns false if the room isn't closed or already archived
+func (r *Repository) ArchiveRoom(roomId uuid.UUID) (bool, *system.Error) {
+
+	t := time.Now()
+
+	result := r.Storage.Instance.Model(&Room{}).
+		Where("id = ?::uuid", roomId).
+		Where("closed_at is not null").
+		Where("archived_at is null").
+		Updates(map[string]interface{}{"archived_at": t, "updated_at": t})
+	if result.Error != nil {
+		return false, system.E(result.Error)
+	}
+
+	r.redisDeleteRooms([]uuid.UUID{roomId})
+
+	return result.RowsAffected > 0, nil
+}
+
+// ArchiveClosedRooms archives rooms closed before the time
+func (r *Repository) ArchiveClosedRooms(closedBefore time.Time) (int64, *system.Error) {
+
+	t := time.Now()
+
+	var roomIds []uuid.UUID
+
+	rows, err := r.Storage.Instance.Raw(`
+		update rooms set archived_at = ?, updated_at = ?
+		where closed_at < ? and archived_at is null
+		returning id
+		`, t, t, closedBefore).Rows()
+	if err != nil {
+		return 0, system.E(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var roomId uuid.UUID
+		if err := rows.Scan(&roomId); err != nil {
+			return 0, system.E(err)
+		}
+		roomIds = append(roomIds, roomId)
+	}
+
+	if len(roomIds) > 0 {
+		r.redisDeleteRooms(roomIds)
+	}
+
+	return int64(len(roomIds)), nil
+}
+
+// CloseRoomsByAccounts closes open rooms of the accounts except rooms of the given types and the given room (if passed)
+func (r *Repository) CloseRoomsByAccounts(accountIds []uuid.UUID, exceptTypes []string, exceptRoomId uuid.UUID) ([]uuid.UUID, *system.Error) {
+
+	var roomIds []uuid.UUID
+
+	if len(accountIds) == 0 {
+		return roomIds, nil
+	}
+
+	closeTime := time.Now()
+
+	params := []interface{}{closeTime, closeTime, accountIds}
+	typesClause := ""
+	if len(exceptTypes) > 0 {
+		typesClause = "r.type not in (?) and"
+		params = append(params, exceptTypes)
+	}
+	if exceptRoomId != uuid.Nil {
+		typesClause += " r.id <> ?::uuid and"
+		params = append(params, exceptRoomId)
+	}
+
+	r.Storage.Instance.Begin()
+	rows, err := r.Storage.Instance.Raw(`
+			update rooms r set closed_at = ?, updated_at = ?
+				where r.closed_at is null and
+                      exists(select 1 
+                                from room_subscribers rs
+								where rs.room_id = r.id and
+                                      rs.account_id in (?) and
+									  rs.observer = 0 and
+									  rs.unsubscribe_at is null	
+							) and
+					  `+typesClause+` true
+			returning r.id
+			`, params...).Rows()
+
+	defer rows.Close()
+
+	if err != nil {
+		r.Storage.Instance.Rollback()
+		return roomIds, &system.Error{
+			Error: err,
+			// TODO: const
+			Message: "[CloseRoomsByAccount]. Error when closing the rooms",
+		}
+	} else {
+
+		r.Storage.Instance.Commit()
+
+		for rows.Next() {
+			var roomId string
+			err := r.Storage.Instance.ScanRows(rows, &roomId)
+			if err != nil {
+				return roomIds, &system.Error{
+					Error:   err,
+					Message: "Query error (scan rows)",
+				}
+			}
+
+			if roomId != "" {
+				roomIds = append(roomIds, uuid.FromStringOrNil(roomId))
+			}
+
+			// clear cache
+			e := r.redisDeleteRooms(roomIds)
+			if e != nil {
+				return roomIds, e
+			}
+
+		}
+
+	}
+
+	return roomIds, nil
+
+}
+
+// FindDirectRoom retrieves an open direct room where both accounts (and only them) are subscribed
+func (r *Repository) FindDirectRoom(firstAccountId, secondAccountId uuid.UUID) (*Room, *system.Error) {
+
+	room := &Room{}
+
+	err := r.Storage.Instance.Raw(`
+		select r.*
+			from rooms r
+			where r.type = 'direct' and
+				  r.closed_at is null and
+				  r.deleted_at is null and
+				  exists(select 1 from room_subscribers rs
+							where rs.room_id = r.id and rs.account_id = ?::uuid and rs.unsubscribe_at is null and rs.observer = 0) and
+				  exists(select 1 from room_subscribers rs
+							where rs.room_id = r.id and rs.account_id = ?::uuid and rs.unsubscribe_at is null and rs.observer = 0) and
+				  (select count(*) from room_subscribers rs
+							where rs.room_id = r.id and rs.unsubscribe_at is null and rs.observer = 0) = 2
+			limit 1
+		`, firstAccountId, secondAccountId).Scan(room).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	if room.Id == uuid.Nil {
+		return nil, nil
+	}
+
+	return room, nil
+}
+
+func (r *Repository) GetRoomSubscribers(roomId uuid.UUID) ([]RoomSubscriber, *system.Error) {
+
+	room, err := r.redisGetRoom(roomId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the cached room keeps unsubscribed accounts as well, they're filtered out the same way as in the db
+	if room != nil {
+		subscribes := []RoomSubscriber{}
+		for _, s := range room.Subscribers {
+			if s.UnsubscribeAt == nil {
+				subscribes = append(subscribes, s)
+			}
+		}
+		return subscribes, nil
+	}
+
+	subscribes := []RoomSubscriber{}
+
+	r.Storage.Instance.
+		Where("room_id = ?::uuid", roomId).
+		Where("unsubscribe_at is null").
+		Find(&subscribes)
+
+	return subscribes, nil
+}
+
+// GetAccountRooms returns the account's rooms sorted by the last activity (the latest first)
+// every room contains the last message visible to the account and the number of messages the account hasn't read yet
+func (db *Repository) GetAccountRooms(criteria *GetAccountRoomsCriteria) ([]AccountRoom, *system.Error) {
+
+	var items []AccountRoom
+
+	params := map[string]interface{}{"accountId": criteria.AccountId, "limit": criteria.Limit}
+
+	where := ""
+	if !criteria.WithClosed {
+		where += " and r.closed_at is null"
+	}
+	if criteria.Before != nil {
+		where += " and (coalesce(r.last_message_at, r.created_at), r.id) < (@beforeAt, @beforeId::uuid)"
+		params["beforeAt"] = criteria.Before.ActivityAt
+		params["beforeId"] = criteria.Before.RoomId
+	}
+
+	err := db.Storage.Instance.Raw(`
+		select r.id, r.reference_id, r.type, r.title, r.avatar_url, r.closed_at,
+		       coalesce(r.last_message_at, r.created_at) as last_activity_at,
+		       lm.id as last_message_id, lm.type as last_message_type, coalesce(lm.message, '') as last_message_text,
+		       lm.account_id as last_message_account_id, lm.created_at as last_message_at,
+		       lm.expired_at is not null as last_message_expired,
+		       lm.moderated_at is not null as last_message_deleted,
+		       (select count(*)
+		        from chat_message_statuses cms
+		        where cms.subscribe_id = rs.id and cms.status = 'recd' and cms.deleted_at is null) as unread_count
+		from room_subscribers rs
+			join rooms r on r.id = rs.room_id
+			left join lateral (
+				select cm.id, cm.type, cm.message, cm.account_id, cm.created_at, cm.expired_at, cm.moderated_at
+				from chat_messages cm
+				where cm.room_id = r.id
+				  and cm.deleted_at is null
+				  and (cm.recipient_account_id is null or cm.recipient_account_id = @accountId::uuid or cm.account_id = @accountId::uuid)
+				  and (cm.visibility <> 'roles' or cm.account_id = @accountId::uuid or cm.visible_roles @> to_jsonb(rs.role::text))
+				order by cm.created_at desc
+				limit 1
+			) lm on true
+		where rs.account_id = @accountId::uuid
+		  and rs.unsubscribe_at is null
+		  and rs.deleted_at is null
+		  and r.deleted_at is null
+		  and r.archived_at is null`+where+`
+		order by last_activity_at desc, r.id desc
+		limit @limit
+		`, params).Scan(&items).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	return items, nil
+}
+
+// PinMessage pins the message in the room unless the room has reached the limit of pins
+// returns false if the message has been already pinned
+func (db *Repository) PinMessage(pin *RoomPinnedMessage, limit int) (bool, *system.Error) {
+
+	tx := db.Storage.Instance.Begin()
+
+	// the room is locked to count pins consistently
+	if err := tx.Exec(`select id from rooms where id = ?::uuid for update`, pin.RoomId).Error; err != nil {
+		tx.Rollback()
+		return false, system.E(err)
+	}
+
+	var count int64
+	if err := tx.Model(&RoomPinnedMessage{}).Where("room_id = ?::uuid", pin.RoomId).Count(&count).Error; err != nil {
+		tx.Rollback()
+		return false, system.E(err)
+	}
+
+	result := tx.Exec(`insert into room_pinned_messages(id, room_id, message_id, account_id)
+						values(@id, @roomId, @messageId, @accountId)
+						on conflict (room_id, message_id) do nothing`,
+		map[string]interface{}{"id": pin.Id, "roomId": pin.RoomId, "messageId": pin.MessageId, "accountId": pin.AccountId})
+	if result.Error != nil {
+		tx.Rollback()
+		return false, system.E(result.Error)
+	}
+
+	if result.RowsAffected > 0 && count >= int64(limit) {
+		tx.Rollback()
+		return false, system.SysErrf(nil, system.RoomPinsLimitCode, nil, pin.RoomId.String(), limit)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return false, system.E(err)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// UnpinMessage returns false if the message hasn't been pinned
+func (db *Repository) UnpinMessage(roomId, messageId uuid.UUID) (bool, *system.Error) {
+
+	result := db.Storage.Instance.Exec(`delete from room_pinned_messages where room_id = ?::uuid and message_id = ?::uuid`, roomId, messageId)
+	if result.Error != nil {
+		return false, system.E(result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// GetPinnedMessages returns pinned messages grouped by rooms (the earliest pinned first)
+func (db *Repository) GetPinnedMessages(roomIds []uuid.UUID) (map[uuid.UUID][]PinnedMessage, *system.Error) {
+
+	res := map[uuid.UUID][]PinnedMessage{}
+
+	if len(roomIds) == 0 {
+		return res, nil
+	}
+
+	var items []PinnedMessage
+	err := db.Storage.Instance.Raw(`
+		select p.room_id, p.message_id, p.account_id as pinned_by, p.created_at as pinned_at,
+		       cm.type, coalesce(cm.message, '') as message, cm.account_id as sender_account_id, cm.created_at as message_created_at
+		from room_pinned_messages p
+			join chat_messages cm on cm.id = p.message_id
+		where p.room_id in (?) and cm.deleted_at is null
+		order by p.created_at`, roomIds).Scan(&items).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	for _, item := range items {
+		res[item.RoomId] = append(res[item.RoomId], item)
+	}
+
+	return res, nil
+}
+
+// StarMessage bookmarks the message for the account
+func (db *Repository) StarMessage(star *ChatMessageStar) *system.Error {
+
+	err := db.Storage.Instance.Exec(`insert into chat_message_stars(id, message_id, account_id)
+						values(@id, @messageId, @accountId)
+						on conflict (account_id, message_id) do nothing`,
+		map[string]interface{}{"id": star.Id, "messageId": star.MessageId, "accountId": star.AccountId}).Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	return nil
+}
+
+func (db *Repository) UnstarMessage(accountId, messageId uuid.UUID) *system.Error {
+
+	err := db.Storage.Instance.Exec(`delete from chat_message_stars where account_id = ?::uuid and message_id = ?::uuid`, accountId, messageId).Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	return nil
+}
+
+func (db *Repository) CreateScheduledMessage(message *ScheduledMessage) *system.Error {
+
+	err := db.Storage.Instance.Create(message).Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	return nil
+}
+
+// GetScheduledMessages returns pending messages of the room (of the given sender only if populated)
+func (db *Repository) GetScheduledMessages(roomId uuid.UUID, accountId uuid.UUID) ([]ScheduledMessage, *system.Error) {
+
+	var messages []ScheduledMessage
+
+	q := db.Storage.Instance.
+		Where("room_id = ?::uuid", roomId).
+		Where("deleted_at is null")
+
+	if accountId != uuid.Nil {
+		q = q.Where("account_id = ?::uuid", accountId)
+	}
+
+	err := q.Order("send_at").Find(&messages).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	return messages, nil
+}
+
+// GetDueScheduledMessages returns pending messages which must be sent by the time
+func (db *Repository) GetDueScheduledMessages(now time.Time) ([]ScheduledMessage, *system.Error) {
+
+	var messages []ScheduledMessage
+
+	err := db.Storage.Instance.
+		Where("send_at <= ?", now).
+		Where("deleted_at is null").
+		Order("send_at").
+		Find(&messages).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	return messages, nil
+}
+
+func (db *Repository) GetScheduledMessage(id uuid.UUID) (*ScheduledMessage, *system.Error) {
+
+	message := &ScheduledMessage{}
+
+	err := db.Storage.Instance.
+		Where("id = ?::uuid", id).
+		Where("deleted_at is null").
+		Limit(1).
+		Find(message).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	if message.Id == uuid.Nil {
+		return nil, nil
+	}
+
+	return message, nil
+}
+
+// DeleteScheduledMessage returns false if the message has been already sent or cancelled
+func (db *Repository) DeleteScheduledMessage(id uuid.UUID) (bool, *system.Error) {
+
+	result := db.Storage.Instance.Exec(`delete from scheduled_messages where id = ?::uuid`, id)
+	if result.Error != nil {
+		return false, system.E(result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (db *Repository) CreateHeldMessage(message *HeldMessage) *system.Error {
+
+	err := db.Storage.Instance.Create(message).Error
+	if err != nil {
+		return system.E(err)
+	}
+
+	return nil
+}
+
+// GetHeldMessages returns messages of the room awaiting the review
+func (db *Repository) GetHeldMessages(roomId uuid.UUID) ([]HeldMessage, *system.Error) {
+
+	var messages []HeldMessage
+
+	err := db.Storage.Instance.
+		Where("room_id = ?::uuid", roomId).
+		Where("deleted_at is null").
+		Order("created_at").
+		Find(&messages).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	return messages, nil
+}
+
+func (db *Repository) GetHeldMessage(id uuid.UUID) (*HeldMessage, *system.Error) {
+
+	message := &HeldMessage{}
+
+	err := db.Storage.Instance.
+		Where("id = ?::uuid", id).
+		Where("deleted_at is null").
+		Limit(1).
+		Find(message).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	if message.Id == uuid.Nil {
+		return nil, nil
+	}
+
+	return message, nil
+}
+
+// DeleteHeldMessage returns false if the message has been already reviewed
+func (db *Repository) DeleteHeldMessage(id uuid.UUID) (bool, *system.Error) {
+
+	result := db.Storage.Instance.Exec(`delete from held_messages where id = ?::uuid`, id)
+	if result.Error != nil {
+		return false, system.E(result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// CreateMessageReport returns false if the account has already reported the message and the report is still open
+func (db *Repository) CreateMessageReport(report *MessageReport) (bool, *system.Error) {
+
+	result := db.Storage.Instance.Exec(`insert into message_reports(id, message_id, room_id, account_id, reason)
+						values(@id, @messageId, @roomId, @accountId, @reason)
+						on conflict (message_id, account_id) where status = 'open' do nothing`,
+		map[string]interface{}{
+			"id":        report.Id,
+			"messageId": report.MessageId,
+			"roomId":    report.RoomId,
+			"accountId": report.AccountId,
+			"reason":    report.Reason,
+		})
+	if result.Error != nil {
+		return false, system.E(result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// GetReportedMessageIds retrieves the page of messages having open reports (the earliest reported first)
+func (db *Repository) GetReportedMessageIds(roomId uuid.UUID, pagingRequest *rep.PagingRequest) ([]uuid.UUID, *rep.PagingResponse, *system.Error) {
+
+	query := db.Storage.Instance.Table("message_reports").Where("status = 'open'")
+
+	if roomId != uuid.Nil {
+		query = query.Where("room_id = ?::uuid", roomId)
+	}
+
+	var totalCount int64
+	if err := query.Distinct("message_id").Count(&totalCount).Error; err != nil {
+		return nil, nil, system.E(err)
+	}
+
+	pagingResponse := &rep.PagingResponse{
+		Total: int(math.Ceil(float64(totalCount) / float64(pagingRequest.Size))),
+		Index: pagingRequest.Index,
+	}
+
+	query = db.Storage.Instance.Table("message_reports").Where("status = 'open'")
+
+	if roomId != uuid.Nil {
+		query = query.Where("room_id = ?::uuid", roomId)
+	}
+
+	var ids []uuid.UUID
+	err := query.
+		Select("message_id").
+		Group("message_id").
+		Order("min(created_at)").
+		Offset((pagingRequest.Index - 1) * pagingRequest.Size).
+		Limit(pagingRequest.Size).
+		Pluck("message_id", &ids).Error
+	if err != nil {
+		return nil, nil, system.E(err)
+	}
+
+	return ids, pagingResponse, nil
+}
 
-	room, err := r.redisGetRoom(id)
+// GetOpenMessageReports returns open reports of the messages
+func (db *Repository) GetOpenMessageReports(messageIds []uuid.UUID) ([]MessageReport, *system.Error) {
+
+	var reports []MessageReport
+
+	if len(messageIds) == 0 {
+		return reports, nil
+	}
+
+	err := db.Storage.Instance.
+		Where("message_id in (?)", messageIds).
+		Where("status = 'open'").
+		Order("created_at").
+		Find(&reports).Error
 	if err != nil {
-		return nil, err
+		return nil, system.E(err)
 	}
 
-	if room != nil {
-		return room, nil
-	} else {
+	return reports, nil
+}
 
-		err := r.Storage.Instance.
-			Preload("Subscribers").
-			First(room, id).Error
+// ResolveMessageReports closes all the open reports of the message, returns the resolved reports
+func (db *Repository) ResolveMessageReports(messageId uuid.UUID, status string, resolvedBy *uuid.UUID, comment string) ([]MessageReport, *system.Error) {
+
+	var reports []MessageReport
+
+	err := db.Storage.Instance.Raw(`
+		update message_reports
+		set status = @status, resolved_by = @resolvedBy, resolved_at = @now, comment = @comment, updated_at = @now
+		where message_id = @messageId::uuid and status = 'open'
+		returning *`,
+		map[string]interface{}{
+			"messageId":  messageId,
+			"status":     status,
+			"resolvedBy": resolvedBy,
+			"comment":    comment,
+			"now":        time.Now(),
+		}).Scan(&reports).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	return reports, nil
+}
+
+// GetMessageContext returns up to the given number of the room's messages sent right before and after the message
+// private and roles-only messages are skipped, the context is shown to moderators
+func (db *Repository) GetMessageContext(message *ChatMessage, size int) ([]ChatMessage, *system.Error) {
+
+	var messages []ChatMessage
+
+	err := db.Storage.Instance.Raw(`
+		select * from (
+			(select * from chat_messages
+			 where room_id = @roomId::uuid and created_at < @createdAt and deleted_at is null
+			   and visibility = 'all' and recipient_account_id is null
+			 order by created_at desc
+			 limit @size)
+			union all
+			(select * from chat_messages
+			 where room_id = @roomId::uuid and created_at > @createdAt and deleted_at is null
+			   and visibility = 'all' and recipient_account_id is null
+			 order by created_at
+			 limit @size)
+		) m
+		order by created_at`,
+		map[string]interface{}{"roomId": message.RoomId, "createdAt": message.CreatedAt, "size": size}).Scan(&messages).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	return messages, nil
+}
+
+// WipeMessage wipes out the content of the message deleted by a moderator and unpins it
+// the message is marked as moderated, so it's distinguished from expired ones
+// returns nil if the message isn't found or has been already wiped out
+func (db *Repository) WipeMessage(messageId uuid.UUID) (*ExpiredMessage, *system.Error) {
+
+	var items []ExpiredMessage
+
+	tx := db.Storage.Instance.Begin()
+
+	now := time.Now()
+	err := tx.Raw(`
+		update chat_messages
+		set message = '', file_id = '', params = null, payload = null, moderated_at = @now, updated_at = @now
+		where id = @id::uuid and expired_at is null and moderated_at is null and deleted_at is null
+		returning id, room_id, account_id, recipient_account_id, visibility, visible_roles`,
+		map[string]interface{}{"id": messageId, "now": now}).Scan(&items).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, system.E(err)
+	}
+
+	if len(items) == 0 {
+		tx.Rollback()
+		return nil, nil
+	}
+
+	result := tx.Exec(`delete from room_pinned_messages where message_id = ?::uuid`, messageId)
+	if result.Error != nil {
+		tx.Rollback()
+		return nil, system.E(result.Error)
+	}
+	items[0].Pinned = result.RowsAffected > 0
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, system.E(err)
+	}
+
+	return &items[0], nil
+}
+
+// ExpireMessages wipes out the content of messages expired by the time
+// the messages are kept as tombstones, so the history remains consistent
+func (db *Repository) ExpireMessages(now time.Time) ([]ExpiredMessage, *system.Error) {
+
+	var items []ExpiredMessage
+
+	tx := db.Storage.Instance.Begin()
+
+	err := tx.Raw(`
+		update chat_messages
+		set message = '', file_id = '', params = null, payload = null, expired_at = @now, updated_at = @now
+		where expires_at <= @now and expired_at is null and moderated_at is null and deleted_at is null
+		returning id, room_id, account_id, recipient_account_id, visibility, visible_roles`,
+		map[string]interface{}{"now": now}).Scan(&items).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, system.E(err)
+	}
+
+	if len(items) > 0 {
+
+		var ids []uuid.UUID
+		for _, item := range items {
+			ids = append(ids, item.Id)
+		}
 
+		rows, err := tx.Raw(`delete from room_pinned_messages where message_id in (?) returning message_id`, ids).Rows()
 		if err != nil {
+			tx.Rollback()
 			return nil, system.E(err)
 		}
 
-		r.redisSetRoom(room)
+		pinned := make(map[uuid.UUID]bool)
+		for rows.Next() {
+			var id uuid.UUID
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				tx.Rollback()
+				return nil, system.E(err)
+			}
+			pinned[id] = true
+		}
+		rows.Close()
 
-		return room, nil
+		for i := range items {
+			items[i].Pinned = pinned[items[i].Id]
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, system.E(err)
 	}
+
+	return items, nil
 }
 
-func (r *Repository) CloseRoom(roomId uuid.UUID) *system.Error {
-	roomModel := &Room{}
+// GetAccountSubscriptions returns all the account's subscriptions including unsubscribed ones
+func (db *Repository) GetAccountSubscriptions(accountId uuid.UUID) ([]AccountSubscription, *system.Error) {
 
-	t := time.Now()
-	roomModel.ClosedAt = &t
-	roomModel.UpdatedAt = t
+	var items []AccountSubscription
 
-	r.Storage.Instance.Model(roomModel).
-		Where("id = ?::uuid", roomId).
-		Updates(map[string]interface{}{"closed_at": roomModel.ClosedAt, "updated_at": roomModel.UpdatedAt})
-	r.redisDeleteRooms([]uuid.UUID{roomModel.Id})
+	err := db.Storage.Instance.Raw(`
+		select rs.room_id, r.reference_id, r.type as room_type, rs.role, rs.observer,
+		       rs.created_at as subscribed_at, rs.unsubscribe_at
+		from room_subscribers rs
+			join rooms r on r.id = rs.room_id
+		where rs.account_id = ?::uuid and rs.deleted_at is null
+		order by rs.created_at`, accountId).Scan(&items).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	return items, nil
+}
+
+// GetAccountSentMessages returns all the messages sent by the account
+func (db *Repository) GetAccountSentMessages(accountId uuid.UUID) ([]ChatMessage, *system.Error) {
+
+	var messages []ChatMessage
+
+	err := db.Storage.Instance.
+		Where("account_id = ?::uuid", accountId).
+		Where("deleted_at is null").
+		Order("created_at").
+		Find(&messages).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	return messages, nil
+}
+
+// GetAccountMessageStatuses returns statuses of messages received by the account
+func (db *Repository) GetAccountMessageStatuses(accountId uuid.UUID) ([]ChatMessageStatus, *system.Error) {
+
+	var statuses []ChatMessageStatus
+
+	err := db.Storage.Instance.
+		Where("account_id = ?::uuid", accountId).
+		Where("deleted_at is null").
+		Order("created_at").
+		Find(&statuses).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	return statuses, nil
+}
+
+// EraseAccountMessages replaces content of the account's messages and their forwarded copies with the placeholder and drops its scheduled and held messages
+func (db *Repository) EraseAccountMessages(accountId uuid.UUID, placeholder string) *system.Error {
+
+	tx := db.Storage.Instance.Begin()
+
+	err := tx.Exec(`
+		update chat_messages
+		set message = @placeholder, file_id = '', params = null, payload = null, updated_at = @now
+		where account_id = @accountId::uuid or forwarded_account_id = @accountId::uuid`,
+		map[string]interface{}{"accountId": accountId, "placeholder": placeholder, "now": time.Now()}).Error
+	if err != nil {
+		tx.Rollback()
+		return system.E(err)
+	}
+
+	err = tx.Exec(`delete from scheduled_messages where account_id = ?::uuid`, accountId).Error
+	if err != nil {
+		tx.Rollback()
+		return system.E(err)
+	}
+
+	err = tx.Exec(`delete from held_messages where account_id = ?::uuid`, accountId).Error
+	if err != nil {
+		tx.Rollback()
+		return system.E(err)
+	}
+
+	err = tx.Exec(`update message_reports set reason = '' where account_id = ?::uuid`, accountId).Error
+	if err != nil {
+		tx.Rollback()
+		return system.E(err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return system.E(err)
+	}
 
 	return nil
 }
 
-func (r *Repository) CloseRoomsByAccounts(accountIds []uuid.UUID) ([]uuid.UUID, *system.Error) {
+// retentionRoomClause builds the condition on rooms (r) matching the filter
+func retentionRoomClause(filter *RetentionRoomFilter) (string, []interface{}) {
 
-	var roomIds []uuid.UUID
+	conditions := []string{"true"}
+	var args []interface{}
 
-	if len(accountIds) == 0 {
-		return roomIds, nil
+	if filter.RoomType != "" {
+		conditions = append(conditions, "r.type = ?")
+		args = append(args, filter.RoomType)
 	}
 
-	closeTime := time.Now()
+	if filter.ReferencePrefix != "" {
+		conditions = append(conditions, "left(r.reference_id, length(?)) = ?")
+		args = append(args, filter.ReferencePrefix, filter.ReferencePrefix)
+	}
 
-	r.Storage.Instance.Begin()
-	rows, err := r.Storage.Instance.Raw(`
-			update rooms r set closed_at = ?, updated_at = ?
-				where r.closed_at is null and
-                      exists(select 1 
-                                from room_subscribers rs
-								where rs.room_id = r.id and
-                                      rs.account_id in (?) and
-									  rs.unsubscribe_at is null	
-							)
-			returning r.id
-			`, closeTime, closeTime, accountIds).Rows()
+	for i := range filter.Except {
+		clause, exceptArgs := retentionRoomClause(&filter.Except[i])
+		conditions = append(conditions, "not ("+clause+")")
+		args = append(args, exceptArgs...)
+	}
 
-	defer rows.Close()
+	return strings.Join(conditions, " and "), args
+}
+
+// CountMessagesToPurge returns the number of messages created before the time in rooms matching the filter
+func (db *Repository) CountMessagesToPurge(filter *RetentionRoomFilter, before time.Time) (int64, *system.Error) {
 
+	clause, args := retentionRoomClause(filter)
+
+	var count int64
+	err := db.Storage.Instance.Raw(`
+		select count(*)
+		from chat_messages cm
+			join rooms r on r.id = cm.room_id
+		where cm.created_at < ? and `+clause, append([]interface{}{before}, args...)...).Row().Scan(&count)
 	if err != nil {
-		r.Storage.Instance.Rollback()
-		return roomIds, &system.Error{
-			Error: err,
-			// TODO: const
-			Message: "[CloseRoomsByAccount]. Error when closing the rooms",
-		}
-	} else {
+		return 0, system.E(err)
+	}
 
-		r.Storage.Instance.Commit()
+	return count, nil
+}
 
-		for rows.Next() {
-			var roomId string
-			err := r.Storage.Instance.ScanRows(rows, &roomId)
-			if err != nil {
-				return roomIds, &system.Error{
-					Error:   err,
-					Message: "Query error (scan rows)",
-				}
-			}
+// PurgeMessages hard deletes a batch of messages created before the time in rooms matching the filter
+// along with their statuses, stars and pins, returns the number of deleted messages
+func (db *Repository) PurgeMessages(filter *RetentionRoomFilter, before time.Time, limit int) (int64, *system.Error) {
 
-			if roomId != "" {
-				roomIds = append(roomIds, uuid.FromStringOrNil(roomId))
-			}
+	clause, args := retentionRoomClause(filter)
 
-			// clear cache
-			e := r.redisDeleteRooms(roomIds)
-			if e != nil {
-				return roomIds, e
-			}
+	var ids []uuid.UUID
+
+	tx := db.Storage.Instance.Begin()
+
+	rows, err := tx.Raw(`
+		select cm.id
+		from chat_messages cm
+			join rooms r on r.id = cm.room_id
+		where cm.created_at < ? and `+clause+`
+		limit ?`, append(append([]interface{}{before}, args...), limit)...).Rows()
+	if err != nil {
+		tx.Rollback()
+		return 0, system.E(err)
+	}
 
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			tx.Rollback()
+			return 0, system.E(err)
 		}
+		ids = append(ids, id)
+	}
+	rows.Close()
 
+	if len(ids) == 0 {
+		tx.Rollback()
+		return 0, nil
 	}
 
-	return roomIds, nil
+	for _, table := range []string{"chat_message_statuses", "chat_message_stars", "room_pinned_messages", "message_reports"} {
+		if err := tx.Exec(`delete from `+table+` where message_id in (?)`, ids).Error; err != nil {
+			tx.Rollback()
+			return 0, system.E(err)
+		}
+	}
+
+	if err := tx.Exec(`delete from chat_messages where id in (?)`, ids).Error; err != nil {
+		tx.Rollback()
+		return 0, system.E(err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return 0, system.E(err)
+	}
 
+	return int64(len(ids)), nil
 }
 
-func (r *Repository) GetRoomSubscribers(roomId uuid.UUID) ([]RoomSubscriber, *system.Error) {
+// CountMessageStatusesToPurge returns the number of message statuses created before the time in rooms matching the filter
+func (db *Repository) CountMessageStatusesToPurge(filter *RetentionRoomFilter, before time.Time) (int64, *system.Error) {
 
-	room, err := r.redisGetRoom(roomId)
+	clause, args := retentionRoomClause(filter)
+
+	var count int64
+	err := db.Storage.Instance.Raw(`
+		select count(*)
+		from chat_message_statuses cms
+			join chat_messages cm on cm.id = cms.message_id
+			join rooms r on r.id = cm.room_id
+		where cms.created_at < ? and `+clause, append([]interface{}{before}, args...)...).Row().Scan(&count)
 	if err != nil {
-		return nil, err
+		return 0, system.E(err)
 	}
 
-	if room != nil {
-		return room.Subscribers, nil
+	return count, nil
+}
+
+// PurgeMessageStatuses hard deletes a batch of message statuses created before the time in rooms matching the filter
+func (db *Repository) PurgeMessageStatuses(filter *RetentionRoomFilter, before time.Time, limit int) (int64, *system.Error) {
+
+	clause, args := retentionRoomClause(filter)
+
+	result := db.Storage.Instance.Exec(`
+		delete from chat_message_statuses
+		where id in (select cms.id
+					 from chat_message_statuses cms
+						 join chat_messages cm on cm.id = cms.message_id
+						 join rooms r on r.id = cm.room_id
+					 where cms.created_at < ? and `+clause+`
+					 limit ?)`, append(append([]interface{}{before}, args...), limit)...)
+	if result.Error != nil {
+		return 0, system.E(result.Error)
 	}
 
-	subscribes := []RoomSubscriber{}
+	return result.RowsAffected, nil
+}
 
-	r.Storage.Instance.
-		Where("room_id = ?::uuid", roomId).
+// GetSubscribersByRooms returns active subscribers grouped by rooms
+func (db *Repository) GetSubscribersByRooms(roomIds []uuid.UUID) (map[uuid.UUID][]RoomSubscriber, *system.Error) {
+
+	res := map[uuid.UUID][]RoomSubscriber{}
+
+	if len(roomIds) == 0 {
+		return res, nil
+	}
+
+	var subscribers []RoomSubscriber
+	err := db.Storage.Instance.
+		Where("room_id in (?)", roomIds).
 		Where("unsubscribe_at is null").
-		Find(&subscribes)
+		Order("created_at").
+		Find(&subscribers).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
 
-	return subscribes, nil
+	for _, s := range subscribers {
+		res[s.RoomId] = append(res[s.RoomId], s)
+	}
+
+	return res, nil
 }
 
 func (db *Repository) GetRooms(criteria *GetRoomCriteria) ([]Room, *system.Error) {
@@ -214,6 +1402,14 @@ func (db *Repository) GetRooms(criteria *GetRoomCriteria) ([]Room, *system.Error
 		q = q.Where("r.closed_at is null")
 	}
 
+	if !criteria.WithArchived {
+		q = q.Where("r.archived_at is null")
+	}
+
+	if criteria.Tags != nil {
+		q = q.Where("r.tags @> ?::jsonb", *criteria.Tags)
+	}
+
 	if criteria.AccountId != uuid.Nil {
 		q = q.Where(`exists(select 1 
 										from room_subscribers rs 
@@ -274,7 +1470,8 @@ func (db *Repository) GetAccountSubscribers(accountId uuid.UUID) map[uuid.UUID]A
 			   rs.account_id,
 			   rs.id as subscriber_id,
 			   rs.role,
-               rs.system_account
+               rs.system_account,
+			   rs.observer
 			from room_subscribers rs
 				join rooms r on r.id = rs.room_id
 			where rs.account_id = ?::uuid and 
@@ -306,7 +1503,8 @@ func (db *Repository) GetRoomAccountSubscribers(roomId uuid.UUID) []AccountSubsc
 		select r.id as room_id,
 			   rs.account_id,
 			   rs.id as subscriber_id,
-			   rs.role	
+			   rs.role,
+			   rs.observer
 			from room_subscribers rs
 				join rooms r on r.id = rs.room_id
 			where r.id = ?::uuid and 
@@ -340,12 +1538,23 @@ func (db *Repository) GetMessageHistory(criteria *GetMessageHistoryCriteria, pag
 		ClientMessageId    string     `gorm:"column:client_message_id"`
 		ReferenceId        string     `gorm:"column:reference_id"`
 		RoomId             uuid.UUID  `gorm:"column:room_id"`
+		RoomType           string     `gorm:"column:room_type"`
 		Type               string     `gorm:"column:type"`
 		Message            string     `gorm:"column:message"`
 		FileId             string     `gorm:"column:file_id"`
 		Params             string     `gorm:"column:params"`
+		Payload            *string    `gorm:"column:payload"`
 		SenderAccountId    uuid.UUID  `gorm:"column:account_id"`
 		RecipientAccountId *uuid.UUID `gorm:"column:recipient_account_id"`
+		Visibility         string     `gorm:"column:visibility"`
+		VisibleRoles       *string    `gorm:"column:visible_roles"`
+		ForwardedMessageId *uuid.UUID `gorm:"column:forwarded_message_id"`
+		ForwardedRoomId    *uuid.UUID `gorm:"column:forwarded_room_id"`
+		ForwardedAccountId *uuid.UUID `gorm:"column:forwarded_account_id"`
+		ForwardedAt        *time.Time `gorm:"column:forwarded_at"`
+		ExpiresAt          *time.Time `gorm:"column:expires_at"`
+		ExpiredAt          *time.Time `gorm:"column:expired_at"`
+		ModeratedAt        *time.Time `gorm:"column:moderated_at"`
 	}
 
 	// here we map incoming sort fields with real fields in the query
@@ -360,12 +1569,23 @@ func (db *Repository) GetMessageHistory(criteria *GetMessageHistoryCriteria, pag
 		  	cm.client_message_id,
 		  	r.reference_id,
 		  	r.id as room_id,
+		  	r.type as room_type,
 		  	cm."type",
 		  	cm.message,
 		  	cm.account_id,
 			cm.file_id,
 			cm.params,
-			cm.recipient_account_id
+			cm.payload,
+			cm.recipient_account_id,
+			cm.visibility,
+			cm.visible_roles,
+			cm.forwarded_message_id,
+			cm.forwarded_room_id,
+			cm.forwarded_account_id,
+			cm.forwarded_at,
+			cm.expires_at,
+			cm.expired_at,
+			cm.moderated_at
 			`
 
 	query := db.Storage.Instance.
@@ -393,6 +1613,14 @@ func (db *Repository) GetMessageHistory(criteria *GetMessageHistoryCriteria, pag
 											from room_subscribers rs
 											where rs.room_id = r.id and
 											rs.account_id = ?::uuid)`, criteria.AccountId)
+		// messages visible to some roles only are retrieved if the account has (had) one of the roles
+		query = query.Where(`(cm.visibility <> 'roles' or
+								cm.account_id = ?::uuid or
+								exists(select 1
+										from room_subscribers rs
+										where rs.room_id = r.id and
+											  rs.account_id = ?::uuid and
+											  cm.visible_roles @> to_jsonb(rs.role::text)))`, criteria.AccountId, criteria.AccountId)
 	}
 
 	if criteria.AccountExternalId != "" {
@@ -401,7 +1629,10 @@ func (db *Repository) GetMessageHistory(criteria *GetMessageHistoryCriteria, pag
 													inner join accounts acc_s on rs.account_id = acc_s.id 
 												where rs.room_id = r.id and
 												acc_s.external_id = ? and
-												(acc_s.id = cm.recipient_account_id or cm.recipient_account_id is null))`, criteria.AccountExternalId)
+												(acc_s.id = cm.recipient_account_id or cm.recipient_account_id is null) and
+												(cm.visibility <> 'roles' or
+												 acc_s.id = cm.account_id or
+												 cm.visible_roles @> to_jsonb(rs.role::text)))`, criteria.AccountExternalId)
 	}
 
 	if criteria.CreatedAfter != nil {
@@ -416,6 +1647,13 @@ func (db *Repository) GetMessageHistory(criteria *GetMessageHistoryCriteria, pag
 		query = query.Where("cm.account_id = ?::uuid", criteria.AccountId)
 	}
 
+	if criteria.StarredOnly {
+		query = query.Where(`exists(select 1
+											from chat_message_stars st
+											where st.message_id = cm.id and
+											st.account_id = ?::uuid)`, criteria.AccountId)
+	}
+
 	if criteria.ReceivedOnly {
 		query = query.Where("cm.account_id <> ?::uuid", criteria.AccountId)
 	}
@@ -461,17 +1699,33 @@ func (db *Repository) GetMessageHistory(criteria *GetMessageHistoryCriteria, pag
 			}
 		}
 
+		var payload string
+		if item.Payload != nil {
+			payload = *item.Payload
+		}
+
 		result = append(result, MessageHistoryItem{
 			Id:                 item.Id,
 			ClientMessageId:    item.ClientMessageId,
 			ReferenceId:        item.ReferenceId,
 			RoomId:             item.RoomId,
+			RoomType:           item.RoomType,
 			Type:               item.Type,
 			Message:            item.Message,
 			FileId:             item.FileId,
 			Params:             jsonParams,
+			Payload:            payload,
 			SenderAccountId:    item.SenderAccountId,
 			RecipientAccountId: item.RecipientAccountId,
+			Visibility:         item.Visibility,
+			VisibleRoles:       item.VisibleRoles,
+			ForwardedMessageId: item.ForwardedMessageId,
+			ForwardedRoomId:    item.ForwardedRoomId,
+			ForwardedAccountId: item.ForwardedAccountId,
+			ForwardedAt:        item.ForwardedAt,
+			ExpiresAt:          item.ExpiresAt,
+			ExpiredAt:          item.ExpiredAt,
+			ModeratedAt:        item.ModeratedAt,
 			Statuses:           []MessageStatus{},
 		})
 		roomMap[item.RoomId] = true
@@ -591,9 +1845,18 @@ func (db *Repository) CreateMessage(messageModel *ChatMessage, opponents []ChatO
 	tx := db.Storage.Instance.Begin()
 	err := tx.Create(messageModel).Error
 	if err != nil {
+		tx.Rollback()
 		return &system.Error{Error: err}
 	}
 
+	// the last message time is denormalized to sort the account's rooms by activity
+	err = tx.Exec(`update rooms set last_message_at = greatest(coalesce(last_message_at, @createdAt), @createdAt) where id = @roomId::uuid`,
+		map[string]interface{}{"roomId": messageModel.RoomId, "createdAt": messageModel.CreatedAt}).Error
+	if err != nil {
+		tx.Rollback()
+		return system.E(err)
+	}
+
 	for _, o := range opponents {
 
 		// we avoid setting status for system account because they aren't expected to read messages
@@ -625,6 +1888,47 @@ func (db *Repository) CreateMessage(messageModel *ChatMessage, opponents []ChatO
 	return nil
 }
 
+func (db *Repository) GetMessage(messageId uuid.UUID) (*ChatMessage, *system.Error) {
+
+	message := &ChatMessage{}
+
+	err := db.Storage.Instance.
+		Where("id = ?::uuid", messageId).
+		Where("deleted_at is null").
+		Limit(1).
+		Find(message).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	if message.Id == uuid.Nil {
+		return nil, nil
+	}
+
+	return message, nil
+}
+
+// GetMessages retrieves messages by ids sorted by the creation time
+func (db *Repository) GetMessages(messageIds []uuid.UUID) ([]ChatMessage, *system.Error) {
+
+	var messages []ChatMessage
+
+	if len(messageIds) == 0 {
+		return messages, nil
+	}
+
+	err := db.Storage.Instance.
+		Where("id in (?)", messageIds).
+		Where("deleted_at is null").
+		Order("created_at").
+		Find(&messages).Error
+	if err != nil {
+		return nil, system.E(err)
+	}
+
+	return messages, nil
+}
+
 func (db *Repository) GetAccountRecdMessages(accountId uuid.UUID, roomId uuid.UUID) ([]ChatMessage, *system.Error) {
 
 	var result []ChatMessage
@@ -634,6 +1938,8 @@ func (db *Repository) GetAccountRecdMessages(accountId uuid.UUID, roomId uuid.UU
 				from chat_messages cm
 				where room_id = ?::uuid and
 					cm.deleted_at is null and
+					cm.expired_at is null and
+					cm.moderated_at is null and
 					exists(select 1 
 							  from chat_message_statuses cms 
 							  where cm.id = cms.message_id and
@@ -31,15 +31,16 @@ func ConvertAccountFromModel(model *a.Account) *Account {
 func (r *AccountConverter) CreateRequestFromProto(request *proto.CreatAccountRequest) (*CreateAccountRequest, *system.Error) {
 
 	result := &CreateAccountRequest{
-		Account:    request.Account,
-		Type:       request.Type,
-		ExternalId: request.ExternalId,
-		FirstName:  request.FirstName,
-		MiddleName: request.MiddleName,
-		LastName:   request.LastName,
-		Email:      request.Email,
-		Phone:      request.Phone,
-		AvatarUrl:  request.AvatarUrl,
+		Account:            request.Account,
+		Type:               request.Type,
+		ExternalId:         request.ExternalId,
+		FirstName:          request.FirstName,
+		MiddleName:         request.MiddleName,
+		LastName:           request.LastName,
+		Email:              request.Email,
+		Phone:              request.Phone,
+		AvatarUrl:          request.AvatarUrl,
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
 	}
 
 	return result, nil
@@ -64,12 +65,13 @@ func (r *AccountConverter) UpdateRequestFromProto(request *proto.UpdateAccountRe
 			AccountId:  request.AccountId.AccountId.ToUUID(),
 			ExternalId: request.AccountId.ExternalId,
 		},
-		FirstName:  request.FirstName,
-		MiddleName: request.MiddleName,
-		LastName:   request.LastName,
-		Email:      request.Email,
-		Phone:      request.Phone,
-		AvatarUrl:  request.AvatarUrl,
+		FirstName:          request.FirstName,
+		MiddleName:         request.MiddleName,
+		LastName:           request.LastName,
+		Email:              request.Email,
+		Phone:              request.Phone,
+		AvatarUrl:          request.AvatarUrl,
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
 	}
 
 	return result, nil
@@ -84,6 +86,31 @@ func (r *AccountConverter) UpdateResponseProtoFromModel(request *UpdateAccountRe
 	return result, nil
 }
 
+func (r *AccountConverter) LockRequestFromProto(request *proto.LockAccountRequest) (*LockAccountRequest, *system.Error) {
+
+	result := &LockAccountRequest{
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
+	}
+
+	if request.AccountId != nil {
+		result.AccountId = AccountIdRequest{
+			AccountId:  request.AccountId.AccountId.ToUUID(),
+			ExternalId: request.AccountId.ExternalId,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *AccountConverter) LockResponseProtoFromModel(request *LockAccountResponse) (*proto.LockAccountResponse, *system.Error) {
+
+	result := &proto.LockAccountResponse{
+		Errors: ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	return result, nil
+}
+
 func (r *AccountConverter) GetByCriteriaRequestFromProto(request *proto.GetAccountsByCriteriaRequest) (*GetAccountsByCriteriaRequest, *system.Error) {
 
 	result := &GetAccountsByCriteriaRequest{
@@ -166,3 +193,71 @@ func (r *AccountConverter) GetOnlineStatusResponseProtoFromModel(request *GetAcc
 
 	return result, nil
 }
+
+func (r *AccountConverter) MergeRequestFromProto(request *proto.MergeAccountsRequest) (*MergeAccountsRequest, *system.Error) {
+
+	result := &MergeAccountsRequest{
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
+	}
+
+	if request.FromAccount != nil {
+		result.FromAccount = AccountIdRequest{
+			AccountId:  request.FromAccount.AccountId.ToUUID(),
+			ExternalId: request.FromAccount.ExternalId,
+		}
+	}
+
+	if request.ToAccount != nil {
+		result.ToAccount = AccountIdRequest{
+			AccountId:  request.ToAccount.AccountId.ToUUID(),
+			ExternalId: request.ToAccount.ExternalId,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *AccountConverter) MergeResponseProtoFromModel(request *MergeAccountsResponse) (*proto.MergeAccountsResponse, *system.Error) {
+
+	result := &proto.MergeAccountsResponse{
+		Errors: ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	return result, nil
+}
+
+func (r *AccountConverter) DataRequestFromProto(request *proto.AccountDataRequest) (*AccountDataRequest, *system.Error) {
+
+	result := &AccountDataRequest{
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
+		Reason:             request.Reason,
+	}
+
+	if request.AccountId != nil {
+		result.Account = AccountIdRequest{
+			AccountId:  request.AccountId.AccountId.ToUUID(),
+			ExternalId: request.AccountId.ExternalId,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *AccountConverter) ExportDataResponseProtoFromModel(request *ExportAccountDataResponse) (*proto.ExportAccountDataResponse, *system.Error) {
+
+	result := &proto.ExportAccountDataResponse{
+		Data:   request.Data,
+		Errors: ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	return result, nil
+}
+
+func (r *AccountConverter) EraseDataResponseProtoFromModel(request *EraseAccountDataResponse) (*proto.EraseAccountDataResponse, *system.Error) {
+
+	result := &proto.EraseAccountDataResponse{
+		Errors: ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	return result, nil
+}
@@ -0,0 +1,232 @@
+package server
+
+import (
+	"archive/zip"
+	"bytes"
+	"chats/app"
+	a "chats/repository/account"
+	au "chats/repository/audit"
+	r "chats/repository/room"
+	"chats/system"
+	"encoding/json"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	AccountDataRequestExport  = "export"
+	AccountDataRequestErasure = "erasure"
+)
+
+const (
+	ErasedMessageText = "Сообщение удалено"
+)
+
+// getDataRequestAccount retrieves the account the data subject request is made for
+func getDataRequestAccount(request *AccountDataRequest) (*a.Account, *system.Error) {
+
+	if request.Account.AccountId == uuid.Nil && request.Account.ExternalId == "" {
+		return nil, system.SysErr(nil, system.IncorrectRequestCode, nil)
+	}
+
+	account, err := a.CreateRepository(app.GetDB()).GetAccount(request.Account.AccountId, request.Account.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil || account.Id == uuid.Nil {
+		return nil, system.SysErrf(nil, system.AccountNotFoundById, nil, accountIdRequestKey(&request.Account))
+	}
+
+	return account, nil
+}
+
+// createDataRequest keeps the record of the processed data subject request
+func createDataRequest(accountId uuid.UUID, requestType string, request *AccountDataRequest) *system.Error {
+
+	model := &a.AccountDataRequest{
+		Id:        system.Uuid(),
+		AccountId: accountId,
+		Type:      requestType,
+		Reason:    request.Reason,
+	}
+	if request.InitiatorAccountId != uuid.Nil {
+		model.InitiatorAccountId = &request.InitiatorAccountId
+	}
+
+	return a.CreateRepository(app.GetDB()).CreateDataRequest(model)
+}
+
+// ExportAccountData builds ZIP archive of the account's profile, room memberships, sent messages and statuses
+func (ws *WsServer) ExportAccountData(request *AccountDataRequest) (*ExportAccountDataResponse, *system.Error) {
+
+	defer app.E().CatchPanic("ExportAccountData")
+
+	account, err := getDataRequestAccount(request)
+	if err != nil {
+		return nil, err
+	}
+
+	roomRep := r.CreateRepository(app.GetDB())
+
+	subscriptions, err := roomRep.GetAccountSubscriptions(account.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := roomRep.GetAccountSentMessages(account.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := roomRep.GetAccountMessageStatuses(account.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &AccountDataProfile{
+		Account:   *ConvertAccountFromModel(account),
+		Status:    account.Status,
+		CreatedAt: account.CreatedAt,
+		ErasedAt:  account.ErasedAt,
+	}
+
+	rooms := []AccountDataRoom{}
+	for _, s := range subscriptions {
+		rooms = append(rooms, AccountDataRoom{
+			RoomId:        s.RoomId,
+			ReferenceId:   s.ReferenceId,
+			RoomType:      s.RoomType,
+			Role:          s.Role,
+			Observer:      system.Uint8ToBool(s.Observer),
+			SubscribedAt:  s.SubscribedAt,
+			UnsubscribeAt: s.UnsubscribeAt,
+		})
+	}
+
+	sent := []AccountDataMessage{}
+	for _, m := range messages {
+
+		params := make(map[string]string)
+		if m.Params != "" {
+			if e := json.Unmarshal([]byte(m.Params), &params); e != nil {
+				return nil, system.SysErr(e, system.UnmarshallingErrorCode, nil)
+			}
+		}
+
+		sent = append(sent, AccountDataMessage{
+			Id:                 m.Id,
+			RoomId:             m.RoomId,
+			Type:               m.Type,
+			Text:               m.Message,
+			Params:             params,
+			Payload:            payloadToRaw(m.Payload),
+			FileId:             m.FileId,
+			RecipientAccountId: m.RecipientAccountId,
+			CreatedAt:          m.CreatedAt,
+		})
+	}
+
+	messageStatuses := []AccountDataStatus{}
+	for _, s := range statuses {
+		messageStatuses = append(messageStatuses, AccountDataStatus{
+			MessageId: s.MessageId,
+			Status:    s.Status,
+			CreatedAt: s.CreatedAt,
+			UpdatedAt: s.UpdatedAt,
+		})
+	}
+
+	buf := &bytes.Buffer{}
+	archive := zip.NewWriter(buf)
+
+	files := []struct {
+		name    string
+		content interface{}
+	}{
+		{"profile.json", profile},
+		{"rooms.json", rooms},
+		{"messages.json", sent},
+		{"statuses.json", messageStatuses},
+	}
+
+	for _, f := range files {
+
+		content, e := json.MarshalIndent(f.content, "", "  ")
+		if e != nil {
+			return nil, system.MarshalError1011(e, nil)
+		}
+
+		writer, e := archive.Create(f.name)
+		if e != nil {
+			return nil, system.E(e)
+		}
+
+		if _, e := writer.Write(content); e != nil {
+			return nil, system.E(e)
+		}
+	}
+
+	if e := archive.Close(); e != nil {
+		return nil, system.E(e)
+	}
+
+	err = createDataRequest(account.Id, AccountDataRequestExport, request)
+	if err != nil {
+		return nil, err
+	}
+
+	writeAudit(request.InitiatorAccountId, AuditActionAccountExport, AuditTargetAccount, account.Id, nil, nil)
+
+	app.L().Debugf("Data of account %s exported", account.Id)
+
+	return &ExportAccountDataResponse{
+		Data:   buf.Bytes(),
+		Errors: []ErrorResponse{},
+	}, nil
+}
+
+// EraseAccountData anonymizes personal data of the account and content of its messages
+// the account itself, its memberships and message statuses are kept, so the rooms' history remains consistent
+func (ws *WsServer) EraseAccountData(request *AccountDataRequest) (*EraseAccountDataResponse, *system.Error) {
+
+	defer app.E().CatchPanic("EraseAccountData")
+
+	account, err := getDataRequestAccount(request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.CreateRepository(app.GetDB()).EraseAccountMessages(account.Id, ErasedMessageText)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.CreateRepository(app.GetDB()).EraseAccount(account)
+	if err != nil {
+		return nil, err
+	}
+
+	err = createDataRequest(account.Id, AccountDataRequestErasure, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// personal data isn't kept in the audit log either
+	err = au.CreateRepository(app.GetDB()).EraseTargetStates(AuditTargetAccount, account.Id)
+	if err != nil {
+		return nil, err
+	}
+	writeAudit(request.InitiatorAccountId, AuditActionAccountErase, AuditTargetAccount, account.Id, nil, nil)
+
+	// live sessions keep the account's data, so they are closed on all the nodes
+	ws.hub.SendMessageToRoom(&RoomMessage{
+		Message: &WSChatResponse{
+			Type: system.SystemMsgTypeAccountDisconnect,
+			Data: &AccountDisconnectMessage{AccountId: account.Id},
+		},
+	})
+
+	app.L().Debugf("Data of account %s erased", account.Id)
+
+	return &EraseAccountDataResponse{Errors: []ErrorResponse{}}, nil
+}
@@ -3,8 +3,6 @@ package server
 import (
 	"chats/proto"
 	"context"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type AccountGrpcService struct {
@@ -67,7 +65,30 @@ func (s *AccountGrpcService) Update(ctx context.Context, rq *proto.UpdateAccount
 }
 
 func (s *AccountGrpcService) Lock(ctx context.Context, rq *proto.LockAccountRequest) (*proto.LockAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+
+	errorRs := &proto.LockAccountResponse{}
+	c := &AccountConverter{}
+
+	modelRq, err := c.LockRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{proto.Err(err)}
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.lockAccount(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{proto.Err(err)}
+		return errorRs, nil
+	}
+
+	protoRs, err := c.LockResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{proto.Err(err)}
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+
 }
 
 func (s *AccountGrpcService) GetByCriteria(ctx context.Context, rq *proto.GetAccountsByCriteriaRequest) (*proto.GetAccountsByCriteriaResponse, error) {
@@ -145,3 +166,81 @@ func (s *AccountGrpcService) GetOnlineStatus(ctx context.Context, rq *proto.GetO
 
 	return protoRs, nil
 }
+
+func (s *AccountGrpcService) Merge(ctx context.Context, rq *proto.MergeAccountsRequest) (*proto.MergeAccountsResponse, error) {
+
+	errorRs := &proto.MergeAccountsResponse{}
+	c := &AccountConverter{}
+
+	modelRq, err := c.MergeRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{proto.Err(err)}
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.mergeAccounts(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{proto.Err(err)}
+		return errorRs, nil
+	}
+
+	protoRs, err := c.MergeResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{proto.Err(err)}
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *AccountGrpcService) ExportData(ctx context.Context, rq *proto.AccountDataRequest) (*proto.ExportAccountDataResponse, error) {
+
+	errorRs := &proto.ExportAccountDataResponse{}
+	c := &AccountConverter{}
+
+	modelRq, err := c.DataRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{proto.Err(err)}
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.ExportAccountData(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{proto.Err(err)}
+		return errorRs, nil
+	}
+
+	protoRs, err := c.ExportDataResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{proto.Err(err)}
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *AccountGrpcService) EraseData(ctx context.Context, rq *proto.AccountDataRequest) (*proto.EraseAccountDataResponse, error) {
+
+	errorRs := &proto.EraseAccountDataResponse{}
+	c := &AccountConverter{}
+
+	modelRq, err := c.DataRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{proto.Err(err)}
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.EraseAccountData(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{proto.Err(err)}
+		return errorRs, nil
+	}
+
+	protoRs, err := c.EraseDataResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{proto.Err(err)}
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
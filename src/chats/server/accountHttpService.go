@@ -0,0 +1,130 @@
+package server
+
+import (
+	"encoding/json"
+	"github.com/gorilla/mux"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+)
+
+type AccountHttpService struct {
+	ws *WsServer
+}
+
+func (s *AccountHttpService) setRouting(router *mux.Router) {
+
+	router.HandleFunc("/api/v1/accounts/merge", func(writer http.ResponseWriter, request *http.Request) {
+		s.Merge(writer, request)
+	}).Methods("POST")
+
+	router.HandleFunc("/api/v1/accounts/lock", func(writer http.ResponseWriter, request *http.Request) {
+		s.Lock(writer, request)
+	}).Methods("POST")
+
+	router.HandleFunc("/api/v1/accounts/export", func(writer http.ResponseWriter, request *http.Request) {
+		s.ExportData(writer, request)
+	}).Methods("GET")
+
+	router.HandleFunc("/api/v1/accounts/erase", func(writer http.ResponseWriter, request *http.Request) {
+		s.EraseData(writer, request)
+	}).Methods("POST")
+
+}
+
+func (s *AccountHttpService) Merge(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &MergeAccountsRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.mergeAccounts(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *AccountHttpService) Lock(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &LockAccountRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.lockAccount(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+// ExportData responds with ZIP archive of the account's data
+func (s *AccountHttpService) ExportData(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &AccountDataRequest{
+		Account: AccountIdRequest{
+			ExternalId: request.FormValue("externalId"),
+		},
+		Reason: request.FormValue("reason"),
+	}
+
+	if accountIdText := request.FormValue("accountId"); accountIdText != "" {
+		accountId, e := uuid.FromString(accountIdText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "accountId error: "+e.Error())
+			return
+		}
+		rq.Account.AccountId = accountId
+	}
+
+	if initiatorText := request.FormValue("initiatorAccountId"); initiatorText != "" {
+		initiatorId, e := uuid.FromString(initiatorText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "initiatorAccountId error: "+e.Error())
+			return
+		}
+		rq.InitiatorAccountId = initiatorId
+	}
+
+	rs, err := s.ws.ExportAccountData(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/zip")
+	writer.Header().Set("Content-Disposition", "attachment; filename=\"account.zip\"")
+	writer.WriteHeader(http.StatusOK)
+	writer.Write(rs.Data)
+
+}
+
+func (s *AccountHttpService) EraseData(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &AccountDataRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.EraseAccountData(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
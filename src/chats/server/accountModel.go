@@ -1,6 +1,10 @@
 package server
 
-import uuid "github.com/satori/go.uuid"
+import (
+	"encoding/json"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
 
 type AccountIdRequest struct {
 	AccountId  uuid.UUID `json:"accountId"`
@@ -17,6 +21,8 @@ type CreateAccountRequest struct {
 	Email      string `json:"email"`
 	Phone      string `json:"phone"`
 	AvatarUrl  string `json:"avatarUrl"`
+	// account (e.g. operator) creating the account, recorded in the audit log
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
 }
 
 type UpdateAccountRequest struct {
@@ -27,12 +33,24 @@ type UpdateAccountRequest struct {
 	Email      string           `json:"email"`
 	Phone      string           `json:"phone"`
 	AvatarUrl  string           `json:"avatarUrl"`
+	// account (e.g. operator) updating the account, recorded in the audit log
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
 }
 
 type UpdateAccountResponse struct {
 	Errors []ErrorResponse `json:"errors"`
 }
 
+type LockAccountRequest struct {
+	AccountId AccountIdRequest `json:"accountId"`
+	// account locking the account (for the audit)
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
+}
+
+type LockAccountResponse struct {
+	Errors []ErrorResponse `json:"errors"`
+}
+
 type CreateAccountResponse struct {
 	AccountId uuid.UUID       `json:"accountId"`
 	Errors    []ErrorResponse `json:"errors"`
@@ -79,3 +97,79 @@ type GetAccountOnlineStatusResponse struct {
 	Errors []ErrorResponse `json:"errors"`
 	Status  string            `json:"status"`
 }
+
+type MergeAccountsRequest struct {
+	// anonymous account
+	FromAccount AccountIdRequest `json:"fromAccount"`
+	// registered account
+	ToAccount AccountIdRequest `json:"toAccount"`
+	// account merging the accounts, recorded in the audit log
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
+}
+
+type MergeAccountsResponse struct {
+	Errors []ErrorResponse `json:"errors"`
+}
+
+type AccountDataRequest struct {
+	Account            AccountIdRequest `json:"account"`
+	// account (e.g. operator) processing the data subject request
+	InitiatorAccountId uuid.UUID        `json:"initiatorAccountId"`
+	Reason             string           `json:"reason"`
+}
+
+type ExportAccountDataResponse struct {
+	// ZIP archive of JSON files
+	Data   []byte          `json:"data"`
+	Errors []ErrorResponse `json:"errors"`
+}
+
+type EraseAccountDataResponse struct {
+	Errors []ErrorResponse `json:"errors"`
+}
+
+type AccountDataProfile struct {
+	Account
+	Status    string     `json:"status"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ErasedAt  *time.Time `json:"erasedAt,omitempty"`
+}
+
+type AccountDataRoom struct {
+	RoomId        uuid.UUID  `json:"roomId"`
+	ReferenceId   string     `json:"referenceId"`
+	RoomType      string     `json:"roomType"`
+	Role          string     `json:"role"`
+	Observer      bool       `json:"observer"`
+	SubscribedAt  time.Time  `json:"subscribedAt"`
+	UnsubscribeAt *time.Time `json:"unsubscribeAt,omitempty"`
+}
+
+type AccountDataMessage struct {
+	Id                 uuid.UUID         `json:"id"`
+	RoomId             uuid.UUID         `json:"roomId"`
+	Type               string            `json:"type"`
+	Text               string            `json:"text"`
+	Params             map[string]string `json:"params,omitempty"`
+	Payload            json.RawMessage   `json:"payload,omitempty"`
+	FileId             string            `json:"fileId,omitempty"`
+	RecipientAccountId *uuid.UUID        `json:"recipientAccountId,omitempty"`
+	CreatedAt          time.Time         `json:"createdAt"`
+}
+
+type AccountDataStatus struct {
+	MessageId uuid.UUID `json:"messageId"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type AccountDisconnectMessage struct {
+	AccountId uuid.UUID `json:"accountId"`
+}
+
+type AccountMergedMessage struct {
+	FromAccountId uuid.UUID   `json:"fromAccountId"`
+	ToAccountId   uuid.UUID   `json:"toAccountId"`
+	RoomIds       []uuid.UUID `json:"roomIds"`
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"chats/app"
 	a "chats/repository/account"
+	r "chats/repository/room"
 	"chats/system"
 	"fmt"
 	uuid "github.com/satori/go.uuid"
@@ -11,6 +12,7 @@ import (
 const (
 	AccountStatusActive = "active"
 	AccountStatusLocked = "locked"
+	AccountStatusMerged = "merged"
 )
 
 const (
@@ -26,6 +28,14 @@ const (
 	OnlineStatusAway    = "away"
 )
 
+// accountIdRequestKey returns the key the account is looked up by (for error messages)
+func accountIdRequestKey(request *AccountIdRequest) string {
+	if request.AccountId == uuid.Nil {
+		return request.ExternalId
+	}
+	return request.AccountId.String()
+}
+
 func validateCreateAccount(account *CreateAccountRequest) (bool, *system.Error) {
 
 	typesMap := map[string]bool {
@@ -97,6 +107,8 @@ func (ws *WsServer) createAccount(request *CreateAccountRequest) (*CreateAccount
 
 	response.AccountId = accountId
 
+	writeAudit(request.InitiatorAccountId, AuditActionAccountCreate, AuditTargetAccount, accountId, nil, auditAccountState(model))
+
 	return response, nil
 }
 
@@ -141,7 +153,7 @@ func (ws *WsServer) setOnlineStatus(request *SetAccountOnlineStatusRequest) (*Se
 
 	// all these statuses suppose the user has live connection
 	if request.Status != OnlineStatusOffline {
-		if _, ok := ws.hub.accountSessions[account.Id]; !ok {
+		if _, ok := ws.hub.getAccountSession(account.Id); !ok {
 			return nil, system.SysErrf(nil, system.AccountOnlineStatusWithoutLiveConnection, nil, request.Status)
 		}
 	}
@@ -209,6 +221,8 @@ func (ws *WsServer) updateAccount(request *UpdateAccountRequest) (*UpdateAccount
 		}
 	}
 
+	before := auditAccountState(account)
+
 	account.LastName = request.LastName
 	account.MiddleName = request.MiddleName
 	account.FirstName = request.FirstName
@@ -220,11 +234,57 @@ func (ws *WsServer) updateAccount(request *UpdateAccountRequest) (*UpdateAccount
 		return nil, err
 	}
 
+	writeAudit(request.InitiatorAccountId, AuditActionAccountUpdate, AuditTargetAccount, account.Id, before, auditAccountState(account))
+
 	response := &UpdateAccountResponse{Errors: []ErrorResponse{}}
 	return response, nil
 
 }
 
+// lockAccount forbids the account to connect, the live sessions are closed
+func (ws *WsServer) lockAccount(request *LockAccountRequest) (*LockAccountResponse, *system.Error) {
+
+	defer app.E().CatchPanic("lockAccount")
+
+	rep := a.CreateRepository(app.GetDB())
+
+	account, err := rep.GetAccount(request.AccountId.AccountId, request.AccountId.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil || account.Id == uuid.Nil {
+		return nil, system.SysErrf(nil, system.AccountNotFoundById, nil, request.AccountId.AccountId.String())
+	}
+
+	if account.Status != AccountStatusActive {
+		return nil, system.SysErrf(nil, system.AccountNotActiveCode, nil, account.Id.String())
+	}
+
+	before := auditAccountState(account)
+
+	err = rep.SetAccountStatus(account, AccountStatusLocked)
+	if err != nil {
+		return nil, err
+	}
+	account.Status = AccountStatusLocked
+
+	writeAudit(request.InitiatorAccountId, AuditActionAccountLock, AuditTargetAccount, account.Id, before, auditAccountState(account))
+
+	ws.hub.SendMessageToRoom(&RoomMessage{
+		Message: &WSChatResponse{
+			Type: system.SystemMsgTypeAccountDisconnect,
+			Data: &AccountDisconnectMessage{AccountId: account.Id},
+		},
+	})
+
+	app.L().Debugf("Account %s locked", account.Id)
+
+	response := &LockAccountResponse{Errors: []ErrorResponse{}}
+	return response, nil
+
+}
+
 func (ws *WsServer) getAccountsByCriteria(criteria *GetAccountsByCriteriaRequest) (*GetAccountsByCriteriaResponse, *system.Error) {
 
 	defer app.E().CatchPanic("getAccountsByCriteria")
@@ -274,3 +334,68 @@ func (ws *WsServer) getAccountById(request *AccountIdRequest) (*Account, *system
 
 	return ConvertAccountFromModel(accountModel), nil
 }
+
+// mergeAccounts merges the anonymous account into the registered one
+func (ws *WsServer) mergeAccounts(request *MergeAccountsRequest) (*MergeAccountsResponse, *system.Error) {
+
+	defer app.E().CatchPanic("mergeAccounts")
+
+	rep := a.CreateRepository(app.GetDB())
+
+	fromAccount, err := rep.GetAccount(request.FromAccount.AccountId, request.FromAccount.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+	if fromAccount == nil || fromAccount.Id == uuid.Nil {
+		return nil, system.SysErrf(nil, system.AccountNotFoundById, nil, accountIdRequestKey(&request.FromAccount))
+	}
+
+	toAccount, err := rep.GetAccount(request.ToAccount.AccountId, request.ToAccount.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+	if toAccount == nil || toAccount.Id == uuid.Nil {
+		return nil, system.SysErrf(nil, system.AccountNotFoundById, nil, accountIdRequestKey(&request.ToAccount))
+	}
+
+	if fromAccount.Id == toAccount.Id {
+		return nil, system.SysErr(nil, system.AccountMergeSameCode, nil)
+	}
+
+	if fromAccount.Type != AccountTypeAnonymousUser || fromAccount.Status == AccountStatusMerged {
+		return nil, system.SysErrf(nil, system.AccountMergeNotAnonymousCode, nil, fromAccount.Id.String())
+	}
+
+	if toAccount.Type == AccountTypeAnonymousUser || toAccount.Status != AccountStatusActive {
+		return nil, system.SysErrf(nil, system.AccountMergeTargetCode, nil, toAccount.Id.String())
+	}
+
+	roomRep := r.CreateRepository(app.GetDB())
+	roomIds, err := roomRep.MergeAccounts(fromAccount.Id, toAccount.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	rep.ClearCache([]uuid.UUID{fromAccount.Id, toAccount.Id}, []string{fromAccount.ExternalId, toAccount.ExternalId})
+
+	writeAudit(request.InitiatorAccountId, AuditActionAccountMerge, AuditTargetAccount, fromAccount.Id, auditAccountState(fromAccount), &AuditAccountState{
+		Status:     AccountStatusMerged,
+		MergedInto: &toAccount.Id,
+	})
+
+	// migrate live sessions on all the nodes
+	ws.hub.SendMessageToRoom(&RoomMessage{
+		Message: &WSChatResponse{
+			Type: system.SystemMsgTypeAccountMerged,
+			Data: &AccountMergedMessage{
+				FromAccountId: fromAccount.Id,
+				ToAccountId:   toAccount.Id,
+				RoomIds:       roomIds,
+			},
+		},
+	})
+
+	response := &MergeAccountsResponse{Errors: []ErrorResponse{}}
+	return response, nil
+
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"chats/proto"
+	"chats/system"
+	uuid "github.com/satori/go.uuid"
+)
+
+type AuditConverter struct{}
+
+func (c *AuditConverter) GetAuditLogRequestFromProto(request *proto.GetAuditLogRequest) (*GetAuditLogRequest, *system.Error) {
+
+	result := &GetAuditLogRequest{
+		ActorAccountId: request.ActorAccountId.ToUUID(),
+		Action:         request.Action,
+		TargetType:     request.TargetType,
+		TargetId:       request.TargetId.ToUUID(),
+		CreatedAfter:   request.CreatedAfter.ToTime(),
+		CreatedBefore:  request.CreatedBefore.ToTime(),
+		PagingRequest: &PagingRequest{
+			Size:  int(request.PageSize),
+			Index: int(request.PageIndex),
+		},
+	}
+
+	return result, nil
+}
+
+func (c *AuditConverter) GetAuditLogResponseProtoFromModel(response *GetAuditLogResponse) (*proto.GetAuditLogResponse, *system.Error) {
+
+	result := &proto.GetAuditLogResponse{
+		Items:  []*proto.AuditLogItem{},
+		Errors: ProtoErrorFromErrorRs(response.Errors),
+	}
+
+	if response.Paging != nil {
+		result.Pages = int32(response.Paging.Total)
+		result.PageIndex = int32(response.Paging.Index)
+	}
+
+	for _, item := range response.Items {
+
+		actorAccountId := uuid.Nil
+		if item.ActorAccountId != nil {
+			actorAccountId = *item.ActorAccountId
+		}
+
+		targetId := uuid.Nil
+		if item.TargetId != nil {
+			targetId = *item.TargetId
+		}
+
+		result.Items = append(result.Items, &proto.AuditLogItem{
+			Id:             proto.FromUUID(item.Id),
+			ActorAccountId: proto.FromUUID(actorAccountId),
+			Action:         item.Action,
+			TargetType:     item.TargetType,
+			TargetId:       proto.FromUUID(targetId),
+			Before:         string(item.Before),
+			After:          string(item.After),
+			CreatedAt:      proto.ToTimestamp(&item.CreatedAt),
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"chats/proto"
+	"context"
+)
+
+type AuditGrpcService struct {
+	ws *WsServer
+	proto.UnimplementedAuditServer
+}
+
+func (s *AuditGrpcService) GetAuditLog(ctx context.Context, rq *proto.GetAuditLogRequest) (*proto.GetAuditLogResponse, error) {
+
+	errorRs := &proto.GetAuditLogResponse{}
+	c := &AuditConverter{}
+
+	modelRq, err := c.GetAuditLogRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{proto.Err(err)}
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.GetAuditLog(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{proto.Err(err)}
+		return errorRs, nil
+	}
+
+	protoRs, err := c.GetAuditLogResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{proto.Err(err)}
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"github.com/gorilla/mux"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type AuditHttpService struct {
+	ws *WsServer
+}
+
+func (s *AuditHttpService) setRouting(router *mux.Router) {
+
+	router.HandleFunc("/api/v1/audit", func(writer http.ResponseWriter, request *http.Request) {
+		s.GetAuditLog(writer, request)
+	}).Methods("GET")
+
+}
+
+func (s *AuditHttpService) GetAuditLog(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &GetAuditLogRequest{
+		Action:        request.FormValue("action"),
+		TargetType:    request.FormValue("targetType"),
+		PagingRequest: &PagingRequest{},
+	}
+
+	if actorText := request.FormValue("actorAccountId"); actorText != "" {
+		actorAccountId, e := uuid.FromString(actorText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "actorAccountId error: "+e.Error())
+			return
+		}
+		rq.ActorAccountId = actorAccountId
+	}
+
+	if targetText := request.FormValue("targetId"); targetText != "" {
+		targetId, e := uuid.FromString(targetText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "targetId error: "+e.Error())
+			return
+		}
+		rq.TargetId = targetId
+	}
+
+	if createdAfterText := request.FormValue("createdAfter"); createdAfterText != "" {
+		createdAfter, e := time.Parse(time.RFC3339, createdAfterText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "createdAfter: "+e.Error())
+			return
+		}
+		rq.CreatedAfter = &createdAfter
+	}
+
+	if createdBeforeText := request.FormValue("createdBefore"); createdBeforeText != "" {
+		createdBefore, e := time.Parse(time.RFC3339, createdBeforeText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "createdBefore: "+e.Error())
+			return
+		}
+		rq.CreatedBefore = &createdBefore
+	}
+
+	if pageSizeText := request.FormValue("pageSize"); pageSizeText != "" {
+		pageSize, e := strconv.Atoi(pageSizeText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "pageSize: "+e.Error())
+			return
+		}
+		rq.PagingRequest.Size = pageSize
+	}
+
+	if pageIndexText := request.FormValue("pageIndex"); pageIndexText != "" {
+		pageIndex, e := strconv.Atoi(pageIndexText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "pageIndex: "+e.Error())
+			return
+		}
+		rq.PagingRequest.Index = pageIndex
+	}
+
+	rs, err := s.ws.GetAuditLog(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusInternalServerError, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"encoding/json"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+const (
+	AuditTargetRoom    = "room"
+	AuditTargetAccount = "account"
+	AuditTargetMessage = "message"
+)
+
+const (
+	AuditActionRoomCreate      = "room.create"
+	AuditActionRoomClose       = "room.close"
+	AuditActionRoomUpdate      = "room.update"
+	AuditActionRoomReopen      = "room.reopen"
+	AuditActionRoomArchive     = "room.archive"
+	AuditActionRoomSubscribe   = "room.subscribe"
+	AuditActionRoomUnsubscribe = "room.unsubscribe"
+	AuditActionRoomTransfer    = "room.transfer"
+	AuditActionAccountCreate   = "account.create"
+	AuditActionAccountUpdate   = "account.update"
+	AuditActionAccountLock     = "account.lock"
+	AuditActionAccountMerge    = "account.merge"
+	AuditActionAccountExport   = "account.export"
+	AuditActionAccountErase    = "account.erase"
+	AuditActionMessageApprove  = "message.approve"
+	AuditActionMessageReject   = "message.reject"
+	AuditActionMessageDismiss  = "message.dismiss"
+	AuditActionMessageDelete   = "message.delete"
+)
+
+type AuditLogItem struct {
+	Id             uuid.UUID       `json:"id"`
+	ActorAccountId *uuid.UUID      `json:"actorAccountId"`
+	Action         string          `json:"action"`
+	TargetType     string          `json:"targetType"`
+	TargetId       *uuid.UUID      `json:"targetId"`
+	Before         json.RawMessage `json:"before,omitempty"`
+	After          json.RawMessage `json:"after,omitempty"`
+	CreatedAt      time.Time       `json:"createdAt"`
+}
+
+type GetAuditLogRequest struct {
+	ActorAccountId uuid.UUID      `json:"actorAccountId"`
+	Action         string         `json:"action"`
+	TargetType     string         `json:"targetType"`
+	TargetId       uuid.UUID      `json:"targetId"`
+	CreatedAfter   *time.Time     `json:"createdAfter"`
+	CreatedBefore  *time.Time     `json:"createdBefore"`
+	PagingRequest  *PagingRequest `json:"pagingRequest"`
+}
+
+type GetAuditLogResponse struct {
+	Items  []AuditLogItem  `json:"items"`
+	Paging *PagingResponse `json:"paging"`
+	Errors []ErrorResponse `json:"errors"`
+}
+
+// states of targets stored in the audit log
+
+type AuditRoomState struct {
+	ReferenceId string     `json:"referenceId,omitempty"`
+	Type        string     `json:"type,omitempty"`
+	Queue       string     `json:"queue,omitempty"`
+	Title       string     `json:"title,omitempty"`
+	Description string     `json:"description,omitempty"`
+	AvatarUrl   string     `json:"avatarUrl,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
+	ClosedAt    *time.Time `json:"closedAt,omitempty"`
+	ArchivedAt  *time.Time `json:"archivedAt,omitempty"`
+	// reason of closing the room
+	Reason      string     `json:"reason,omitempty"`
+}
+
+type AuditSubscriberState struct {
+	AccountId uuid.UUID `json:"accountId"`
+	Role      string    `json:"role,omitempty"`
+	Observer  bool      `json:"observer,omitempty"`
+}
+
+type AuditAccountState struct {
+	Account    string     `json:"account,omitempty"`
+	Type       string     `json:"type,omitempty"`
+	Status     string     `json:"status,omitempty"`
+	ExternalId string     `json:"externalId,omitempty"`
+	FirstName  string     `json:"firstName,omitempty"`
+	MiddleName string     `json:"middleName,omitempty"`
+	LastName   string     `json:"lastName,omitempty"`
+	Email      string     `json:"email,omitempty"`
+	Phone      string     `json:"phone,omitempty"`
+	AvatarUrl  string     `json:"avatarUrl,omitempty"`
+	MergedInto *uuid.UUID `json:"mergedInto,omitempty"`
+}
@@ -0,0 +1,183 @@
+package server
+
+import (
+	"chats/app"
+	"chats/repository"
+	a "chats/repository/account"
+	au "chats/repository/audit"
+	r "chats/repository/room"
+	"chats/system"
+	"encoding/json"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+func auditStateToString(state interface{}) *string {
+
+	if state == nil {
+		return nil
+	}
+
+	b, err := json.Marshal(state)
+	if err != nil {
+		app.E().SetError(system.MarshalError1011(err, nil))
+		return nil
+	}
+
+	s := string(b)
+	return &s
+}
+
+func auditRoomState(room *r.Room) *AuditRoomState {
+	return &AuditRoomState{
+		ReferenceId: room.ReferenceId,
+		Type:        room.Type,
+		Queue:       room.Queue,
+		Title:       room.Title,
+		Description: room.Description,
+		AvatarUrl:   room.AvatarUrl,
+		Tags:        stringListFromString(room.Tags),
+		ClosedAt:    room.ClosedAt,
+		ArchivedAt:  room.ArchivedAt,
+	}
+}
+
+func auditAccountState(account *a.Account) *AuditAccountState {
+	return &AuditAccountState{
+		Account:    account.Account,
+		Type:       account.Type,
+		Status:     account.Status,
+		ExternalId: account.ExternalId,
+		FirstName:  account.FirstName,
+		MiddleName: account.MiddleName,
+		LastName:   account.LastName,
+		Email:      account.Email,
+		Phone:      account.Phone,
+		AvatarUrl:  account.AvatarUrl,
+		MergedInto: account.MergedInto,
+	}
+}
+
+// writeAudit records the administrative action
+// the action has been already performed, so a failure is logged only
+func writeAudit(actorAccountId uuid.UUID, action string, targetType string, targetId uuid.UUID, before, after interface{}) {
+
+	item := &au.AuditLog{
+		Id:         system.Uuid(),
+		Action:     action,
+		TargetType: targetType,
+	}
+
+	if actorAccountId != uuid.Nil {
+		item.ActorAccountId = &actorAccountId
+	}
+
+	if targetId != uuid.Nil {
+		item.TargetId = &targetId
+	}
+
+	item.Before = auditStateToString(before)
+	item.After = auditStateToString(after)
+
+	if err := au.CreateRepository(app.GetDB()).CreateAuditLog(item); err != nil {
+		app.E().SetError(err)
+	}
+}
+
+// auditRoomsClosed records closing of the rooms
+func auditRoomsClosed(actorAccountId uuid.UUID, roomIds []uuid.UUID, reason string) {
+	closedAt := time.Now()
+	for _, roomId := range roomIds {
+		writeAudit(actorAccountId, AuditActionRoomClose, AuditTargetRoom, roomId, nil, &AuditRoomState{ClosedAt: &closedAt, Reason: reason})
+	}
+}
+
+// purgeAuditLog deletes the audit log records older than the configured period
+func (ws *WsServer) purgeAuditLog() {
+
+	defer app.E().CatchPanic("purgeAuditLog")
+
+	days := app.Instance.Env.AuditLogRetentionDays()
+	if days == 0 {
+		return
+	}
+
+	before := time.Now().AddDate(0, 0, -days)
+
+	if app.Instance.Env.RetentionDryRun() {
+		app.L().Infof("Retention (dry run): audit log created before %s would be deleted", before.Format(time.RFC3339))
+		return
+	}
+
+	rep := au.CreateRepository(app.GetDB())
+	batchSize := app.Instance.Env.RetentionBatchSize()
+
+	var total int64
+	for {
+		deleted, err := rep.PurgeAuditLog(before, batchSize)
+		if err != nil {
+			app.E().SetError(err)
+			return
+		}
+
+		total += deleted
+		if deleted > 0 {
+			app.L().Infof("Retention: %d audit log records deleted (%d in total)", deleted, total)
+		}
+
+		if deleted < int64(batchSize) {
+			return
+		}
+	}
+}
+
+func (ws *WsServer) GetAuditLog(request *GetAuditLogRequest) (*GetAuditLogResponse, *system.Error) {
+
+	defer app.E().CatchPanic("GetAuditLog")
+
+	pagingRq := &repository.PagingRequest{Index: 1, Size: 100}
+	if request.PagingRequest != nil {
+		if request.PagingRequest.Index > 0 {
+			pagingRq.Index = request.PagingRequest.Index
+		}
+		if request.PagingRequest.Size > 0 {
+			pagingRq.Size = request.PagingRequest.Size
+		}
+	}
+
+	items, pagingRs, err := au.CreateRepository(app.GetDB()).GetAuditLog(&au.GetAuditLogCriteria{
+		ActorAccountId: request.ActorAccountId,
+		Action:         request.Action,
+		TargetType:     request.TargetType,
+		TargetId:       request.TargetId,
+		CreatedAfter:   request.CreatedAfter,
+		CreatedBefore:  request.CreatedBefore,
+	}, pagingRq)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuditLogResponse{
+		Items: []AuditLogItem{},
+		Paging: &PagingResponse{
+			Total: pagingRs.Total,
+			Index: pagingRs.Index,
+		},
+		Errors: []ErrorResponse{},
+	}
+
+	for _, item := range items {
+		response.Items = append(response.Items, AuditLogItem{
+			Id:             item.Id,
+			ActorAccountId: item.ActorAccountId,
+			Action:         item.Action,
+			TargetType:     item.TargetType,
+			TargetId:       item.TargetId,
+			Before:         payloadToRaw(item.Before),
+			After:          payloadToRaw(item.After),
+			CreatedAt:      item.CreatedAt,
+		})
+	}
+
+	return response, nil
+}
@@ -26,8 +26,22 @@ func (ws *WsServer) messageToRoom(message *RoomMessage) *system.Error {
 		app.L().Debugf("Sessions for room %s count %d", message.RoomId.String(), len(sessionIds))
 		app.L().Debugf("Subscribers for room %s count %d", message.RoomId.String(), len(room.subscribers))
 
+		// accounts allowed to get the message if it's visible to some roles only
+		var visibleAccounts map[uuid.UUID]bool
+		if len(message.VisibleRoles) > 0 {
+			visibleAccounts = make(map[uuid.UUID]bool)
+			for _, s := range room.getSubscribers() {
+				if roleVisible(message.VisibleRoles, s.Role) {
+					visibleAccounts[s.AccountId] = true
+				}
+			}
+		}
+
 		for _, sessionId := range sessionIds {
-			if session, ok := ws.hub.sessions[sessionId]; ok {
+			if session, ok := ws.hub.getSession(sessionId); ok {
+				if visibleAccounts != nil && !visibleAccounts[session.account.Id] {
+					continue
+				}
 				go ws.hub.sendMessage(session, answer)
 			}
 		}
@@ -47,7 +61,7 @@ func (ws *WsServer) messageToAccount(message *RoomMessage) *system.Error {
 		return system.SysErr(err, system.WsCreateClientResponseCode, nil)
 	}
 
-	if session, ok := ws.hub.accountSessions[message.AccountId]; ok {
+	if session, ok := ws.hub.getAccountSession(message.AccountId); ok {
 		app.L().Debugf("Session for accountId: %s sessionId: %s", message.AccountId.String(), session.sessionId.String())
 		go ws.hub.sendMessage(session, answer)
 	} else {
@@ -65,6 +79,40 @@ func (ws *WsServer) messageToAccount(message *RoomMessage) *system.Error {
 
 }
 
+// notifySubscriberChanged sends the event about joined or left subscriber to sessions of the room
+// observers stay silent
+func (ws *WsServer) notifySubscriberChanged(room *Room, eventType string, subscriber r.AccountSubscriber) *system.Error {
+
+	if system.Uint8ToBool(subscriber.Observer) {
+		return nil
+	}
+
+	account, err := ws.getAccountById(&AccountIdRequest{AccountId: subscriber.AccountId})
+	if err != nil {
+		return err
+	}
+
+	answer, e := json.Marshal(&WSChatResponse{
+		Type: eventType,
+		Data: &WSSubscriberDataResponse{
+			RoomId:  room.roomId,
+			Role:    subscriber.Role,
+			Account: account,
+		},
+	})
+	if e != nil {
+		return system.SysErr(e, system.WsCreateClientResponseCode, nil)
+	}
+
+	for _, sessionId := range room.getRoomSessionIds() {
+		if session, ok := ws.hub.getSession(sessionId); ok {
+			go ws.hub.sendMessage(session, answer)
+		}
+	}
+
+	return nil
+}
+
 func (ws *WsServer) userSubscribe(data []byte) *system.Error {
 
 	defer app.E().CatchPanic("consumer.userSubscribe")
@@ -79,31 +127,39 @@ func (ws *WsServer) userSubscribe(data []byte) *system.Error {
 
 	rep := r.CreateRepository(app.GetDB())
 
-	if session, ok := ws.hub.accountSessions[message.Message.Data.AccountId]; ok {
-
-		rep := r.CreateRepository(app.GetDB())
-		app.L().Debugf("Session %s found by account %s", session.sessionId, message.Message.Data.AccountId)
+	var room *Room
+	if sessions := ws.hub.getAccountSessions(message.Message.Data.AccountId); len(sessions) > 0 {
 
 		// search for subscribers by session account
 		subscribers := rep.GetAccountSubscribers(message.Message.Data.AccountId)
 		app.L().Debugf("Subscribers found: %s", subscribers)
 
-		ws.hub.accountSessions[message.Message.Data.AccountId].SetSubscribers(subscribers)
-
 		//	update room
-		room := ws.hub.LoadRoomIfNotExists(message.Message.Data.RoomId)
-		room.AddSession(session.sessionId)
-		session.rooms[message.Message.Data.RoomId] = room
+		room = ws.hub.LoadRoomIfNotExists(message.Message.Data.RoomId)
+
+		for _, session := range sessions {
+			app.L().Debugf("Session %s found by account %s", session.sessionId, message.Message.Data.AccountId)
+			session.SetSubscribers(subscribers)
+			room.AddSession(session.sessionId)
+			session.addRoom(room)
+		}
 
 		app.L().Debug("account " + message.Message.Data.AccountId.String() + " added to room")
 
-	} else if room, ok := ws.hub.rooms[message.Message.Data.RoomId]; ok {
+	} else if loaded, ok := ws.hub.rooms[message.Message.Data.RoomId]; ok {
+		room = loaded
 		subscribers := rep.GetRoomAccountSubscribers(message.Message.Data.RoomId)
 		room.UpdateSubscribers(subscribers)
 		app.L().Debug("room " + message.Message.Data.RoomId.String() + " updated subscribers")
 
 	}
 
+	if room != nil {
+		if subscriber, ok := room.getSubscriber(message.Message.Data.AccountId); ok {
+			return ws.notifySubscriberChanged(room, EventSubscriberJoined, subscriber)
+		}
+	}
+
 	return nil
 
 }
@@ -120,14 +176,153 @@ func (ws *WsServer) userUnSubscribe(data []byte) *system.Error {
 
 	app.L().Debugf("User unsubscribe message %s", message)
 
-	if cli, ok := ws.hub.accountSessions[message.Message.Data.AccountId]; ok {
-		if room, ok := cli.rooms[message.Message.Data.RoomId]; ok {
-			room.removeSession(cli)
+	// the left account gets the event as well, so notify before detaching its sessions
+	if room, ok := ws.hub.rooms[message.Message.Data.RoomId]; ok {
+		subscriber, found := room.getSubscriber(message.Message.Data.AccountId)
+
+		rep := r.CreateRepository(app.GetDB())
+		room.UpdateSubscribers(rep.GetRoomAccountSubscribers(message.Message.Data.RoomId))
+
+		if found {
+			if err := ws.notifySubscriberChanged(room, EventSubscriberLeft, subscriber); err != nil {
+				app.E().SetError(err)
+			}
+		}
+	}
+
+	for _, session := range ws.hub.getAccountSessions(message.Message.Data.AccountId) {
+		if room := session.removeRoom(message.Message.Data.RoomId); room != nil {
+			room.removeSession(session)
 		}
 	}
 	return nil
 }
 
+// roomClosed notifies sessions of the closed rooms and unloads the rooms from the hub
+func (ws *WsServer) roomClosed(data []byte) *system.Error {
+
+	defer app.E().CatchPanic("consumer.roomClosed")
+
+	message := &WSSystemRoomClosedRequest{}
+	err := json.Unmarshal(data, message)
+	if err != nil {
+		return system.UnmarshalError1010(err, data)
+	}
+
+	app.L().Debugf("Room closed message %s", message)
+
+	for _, roomId := range message.Message.Data.RoomIds {
+		if room, ok := ws.hub.rooms[roomId]; ok {
+			answer, err := json.Marshal(&WSChatResponse{
+				Type: EventRoomClosed,
+				Data: &WSRoomClosedDataResponse{
+					RoomId: roomId,
+					Reason: message.Message.Data.Reason,
+				},
+			})
+			if err != nil {
+				return system.SysErr(err, system.WsCreateClientResponseCode, nil)
+			}
+			for _, sessionId := range room.getRoomSessionIds() {
+				if session, ok := ws.hub.getSession(sessionId); ok {
+					go ws.hub.sendMessage(session, answer)
+				}
+			}
+		}
+	}
+
+	ws.hub.removeRooms(message.Message.Data.RoomIds)
+
+	return nil
+}
+
+// accountMerged switches live sessions of the merged account to the target account
+func (ws *WsServer) accountMerged(data []byte) *system.Error {
+
+	defer app.E().CatchPanic("consumer.accountMerged")
+
+	message := &WSSystemAccountMergedRequest{}
+	err := json.Unmarshal(data, message)
+	if err != nil {
+		return system.UnmarshalError1010(err, data)
+	}
+
+	app.L().Debugf("Account merged message %s", message)
+
+	merged := message.Message.Data
+
+	// rooms loaded on the node must get actual subscribers
+	for _, roomId := range merged.RoomIds {
+		if room, ok := ws.hub.getRoom(roomId); ok {
+			rep := r.CreateRepository(app.GetDB())
+			room.UpdateSubscribers(rep.GetRoomAccountSubscribers(roomId))
+		}
+	}
+
+	sessions := ws.hub.getAccountSessions(merged.FromAccountId)
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	account, sysErr := ws.getAccountById(&AccountIdRequest{AccountId: merged.ToAccountId})
+	if sysErr != nil {
+		return sysErr
+	}
+
+	rep := r.CreateRepository(app.GetDB())
+	subscribers := rep.GetAccountSubscribers(merged.ToAccountId)
+
+	answer, err := json.Marshal(&WSChatResponse{
+		Type: EventAccountMerged,
+		Data: &WSAccountMergedDataResponse{
+			FromAccountId: merged.FromAccountId,
+			ToAccountId:   merged.ToAccountId,
+		},
+	})
+	if err != nil {
+		return system.SysErr(err, system.WsCreateClientResponseCode, nil)
+	}
+
+	ws.hub.switchAccount(sessions, merged.FromAccountId, account)
+
+	for _, session := range sessions {
+		session.SetSubscribers(subscribers)
+		for roomId := range subscribers {
+			room := ws.hub.LoadRoomIfNotExists(roomId)
+			room.AddSession(session.sessionId)
+			session.addRoom(room)
+		}
+		go ws.hub.sendMessage(session, answer)
+	}
+
+	app.L().Debugf("Sessions of account %s switched to account %s", merged.FromAccountId, merged.ToAccountId)
+
+	return nil
+}
+
+// accountDisconnect closes live sessions of the account on the node
+func (ws *WsServer) accountDisconnect(data []byte) *system.Error {
+
+	defer app.E().CatchPanic("consumer.accountDisconnect")
+
+	message := &WSSystemAccountDisconnectRequest{}
+	err := json.Unmarshal(data, message)
+	if err != nil {
+		return system.UnmarshalError1010(err, data)
+	}
+
+	accountId := message.Message.Data.AccountId
+
+	// the sessions are cleaned up by the hub as soon as the connections are closed
+	for _, session := range ws.hub.getAccountSessions(accountId) {
+		session.conn.Close()
+	}
+
+	app.L().Debugf("Sessions of account %s disconnected", accountId)
+
+	return nil
+}
+
 func (ws *WsServer) internalConsumer() {
 
 	dataChan := make(chan []byte, 1024)
@@ -178,6 +373,27 @@ func (ws *WsServer) internalConsumer() {
 						app.E().SetError(err)
 					}
 					break
+
+				case system.SystemMsgTypeRoomClosed:
+					err := ws.roomClosed(data)
+					if err != nil {
+						app.E().SetError(err)
+					}
+					break
+
+				case system.SystemMsgTypeAccountMerged:
+					err := ws.accountMerged(data)
+					if err != nil {
+						app.E().SetError(err)
+					}
+					break
+
+				case system.SystemMsgTypeAccountDisconnect:
+					err := ws.accountDisconnect(data)
+					if err != nil {
+						app.E().SetError(err)
+					}
+					break
 			}
 		}
 	}
@@ -5,7 +5,6 @@ import (
 	r "chats/repository/room"
 	"chats/system"
 	"encoding/json"
-	uuid "github.com/satori/go.uuid"
 	"time"
 )
 
@@ -56,11 +55,7 @@ func (ws *WsServer) provider() {
 	for {
 		time.Sleep(4 * time.Second)
 
-		actualAccountIds := make([]uuid.UUID, 0, len(ws.hub.accounts))
-
-		for accountId := range ws.hub.accounts {
-			actualAccountIds = append(actualAccountIds, accountId)
-		}
+		actualAccountIds := ws.hub.getAccountIds()
 
 		cronMessage := &CronSendOnlineUsers{
 			Type: MessageTypeSendOnlineUsers,
@@ -16,6 +16,25 @@ const (
 	EventTyping                = "typing"
 	EventOpponentStatus        = "opponentStatus"
 	EventClientConnectionError = "clientConnectionError"
+	EventButtonClick           = "buttonClick"
+	EventRoomTransferred       = "roomTransferred"
+	EventAccountMerged         = "accountMerged"
+	EventRoomClosed            = "roomClosed"
+	EventSubscriberJoined      = "subscriberJoined"
+	EventSubscriberLeft        = "subscriberLeft"
+	EventRoomUpdated           = "roomUpdated"
+	EventForwardMessages       = "forwardMessages"
+	EventError                 = "error"
+	EventPinMessage            = "pinMessage"
+	EventUnpinMessage          = "unpinMessage"
+	EventPinsChanged           = "pinsChanged"
+	EventStarMessage           = "starMessage"
+	EventUnstarMessage         = "unstarMessage"
+	EventMessageExpired        = "messageExpired"
+	EventMessageHeld           = "messageHeld"
+	EventMessageDeleted        = "messageDeleted"
+	EventReportMessage         = "reportMessage"
+	EventReportResolved        = "reportResolved"
 )
 
 const (
@@ -47,6 +66,7 @@ func (e *Event) EventMessage(h *Hub, c *Session, clientRequest []byte) {
 		Data:            SendChatMessagesDataRequest{
 			Messages: []SendChatMessageDataRequest{},
 		},
+		RateLimited:     true,
 	}
 
 	if clRq.SenderAccountId != uuid.Nil {
@@ -56,16 +76,38 @@ func (e *Event) EventMessage(h *Hub, c *Session, clientRequest []byte) {
 	}
 
 	for _, m := range clRq.Data.Messages {
+
+		// messages over the account's limit are dropped, so a single client can't flood the rooms
+		if wait, sysErr := wsServer.rateLimiter.checkAccount(c.account.Id); sysErr != nil {
+			c.sendError(&WSErrorDataResponse{
+				Code:            sysErr.Code,
+				Message:         sysErr.Message,
+				RoomId:          m.RoomId,
+				ClientMessageId: m.ClientMessageId,
+				RetryAfter:      wait.Milliseconds(),
+			})
+			continue
+		}
+
 		request.Data.Messages = append(request.Data.Messages, SendChatMessageDataRequest{
 			ClientMessageId:    m.ClientMessageId,
 			RoomId:             m.RoomId,
 			Type:               m.Type,
 			Text:               m.Text,
 			Params:             m.Params,
+			Payload:            m.Payload,
 			RecipientAccountId: m.RecipientAccountId,
+			Visibility:         m.Visibility,
+			VisibleRoles:       m.VisibleRoles,
+			SendAt:             m.SendAt,
+			Ttl:                m.Ttl,
 		})
 	}
 
+	if len(request.Data.Messages) == 0 {
+		return
+	}
+
 	_, srvErr := wsServer.SendChatMessages(request)
 	if srvErr != nil {
 		app.E().SetError(srvErr)
@@ -85,7 +127,20 @@ func (e *Event) EventMessageStatus(h *Hub, c *Session, clientRequest []byte) {
 	}
 
 	rep := r.CreateRepository(app.GetDB())
-	sysErr := rep.SetReadStatus(request.Data.MessageId, c.account.Id)
+
+	// observers don't affect read statuses
+	subscribers, sysErr := rep.GetRoomSubscribers(request.Data.RoomId)
+	if sysErr != nil {
+		app.E().SetError(sysErr)
+		return
+	}
+	for _, s := range subscribers {
+		if s.AccountId == c.account.Id && system.Uint8ToBool(s.Observer) {
+			return
+		}
+	}
+
+	sysErr = rep.SetReadStatus(request.Data.MessageId, c.account.Id)
 
 	if sysErr != nil {
 		app.E().SetError(system.SysErr(err, system.WsChangeMessageStatusErrorCode, clientRequest))
@@ -130,6 +185,11 @@ func (e *Event) EventOpponentStatus(h *Hub, c *Session, clientRequest []byte) {
 
 	for _, subscribe := range subscribes {
 
+		// observers are invisible to other subscribers
+		if system.Uint8ToBool(subscribe.Observer) {
+			continue
+		}
+
 		account := &WSAccountStatusModel{AccountId: subscribe.AccountId}
 
 		if c.account.Id != subscribe.AccountId && !system.Uint8ToBool(subscribe.SystemAccount) {
@@ -217,7 +277,26 @@ func (e *Event) EventTyping(h *Hub, c *Session, clientRequest []byte) {
 	}
 
 	if room, ok := h.rooms[request.Data.RoomId]; ok {
-		for _, subscriber := range room.subscribers {
+
+		// typing is shown only for accounts which can send messages
+		senderFound := false
+		for _, subscriber := range room.getSubscribers() {
+			if subscriber.AccountId == c.account.Id {
+				if system.Uint8ToBool(subscriber.Observer) {
+					return
+				}
+				if srvErr := checkSubscriberCapability(room.roomId, c.account.Id, subscriber.Role, CapabilitySend); srvErr != nil {
+					app.E().SetError(srvErr)
+					return
+				}
+				senderFound = true
+			}
+		}
+		if !senderFound {
+			return
+		}
+
+		for _, subscriber := range room.getSubscribers() {
 			if subscriber.AccountId != c.account.Id {
 
 				message := EventTypingMessage
@@ -235,8 +314,6 @@ func (e *Event) EventTyping(h *Hub, c *Session, clientRequest []byte) {
 				}
 
 				h.SendMessageToRoom(response)
-
-				return
 			}
 		}
 	}
@@ -244,6 +321,126 @@ func (e *Event) EventTyping(h *Hub, c *Session, clientRequest []byte) {
 	return
 }
 
+func (e *Event) EventButtonClick(h *Hub, c *Session, clientRequest []byte) {
+
+	defer app.E().CatchPanic("EventButtonClick")
+
+	request := &WSChatButtonClickRequest{}
+	err := json.Unmarshal(clientRequest, request)
+	if err != nil {
+		app.E().SetError(system.UnmarshalRequestError1201(err, clientRequest))
+		return
+	}
+
+	srvErr := wsServer.ButtonClick(c.account.Id, &request.Data)
+	if srvErr != nil {
+		app.E().SetError(srvErr)
+	}
+
+}
+
+func (e *Event) EventForwardMessages(h *Hub, c *Session, clientRequest []byte) {
+
+	defer app.E().CatchPanic("EventForwardMessages")
+
+	request := &WSForwardMessagesRequest{}
+	err := json.Unmarshal(clientRequest, request)
+	if err != nil {
+		app.E().SetError(system.UnmarshalRequestError1201(err, clientRequest))
+		return
+	}
+
+	_, srvErr := wsServer.ForwardMessages(&ForwardMessagesRequest{
+		AccountId:    c.account.Id,
+		SourceRoomId: request.Data.SourceRoomId,
+		TargetRoomId: request.Data.TargetRoomId,
+		MessageIds:   request.Data.MessageIds,
+	})
+	if srvErr != nil {
+		app.E().SetError(srvErr)
+	}
+
+}
+
+func (e *Event) EventPinMessage(h *Hub, c *Session, clientRequest []byte) {
+
+	defer app.E().CatchPanic("EventPinMessage")
+
+	request := &WSPinMessageRequest{}
+	err := json.Unmarshal(clientRequest, request)
+	if err != nil {
+		app.E().SetError(system.UnmarshalRequestError1201(err, clientRequest))
+		return
+	}
+
+	rq := &PinMessageRequest{
+		RoomId:             request.Data.RoomId,
+		MessageId:          request.Data.MessageId,
+		InitiatorAccountId: c.account.Id,
+	}
+
+	var srvErr *system.Error
+	if request.Type == EventUnpinMessage {
+		_, srvErr = wsServer.UnpinMessage(rq)
+	} else {
+		_, srvErr = wsServer.PinMessage(rq)
+	}
+	if srvErr != nil {
+		app.E().SetError(srvErr)
+	}
+
+}
+
+func (e *Event) EventStarMessage(h *Hub, c *Session, clientRequest []byte) {
+
+	defer app.E().CatchPanic("EventStarMessage")
+
+	request := &WSStarMessageRequest{}
+	err := json.Unmarshal(clientRequest, request)
+	if err != nil {
+		app.E().SetError(system.UnmarshalRequestError1201(err, clientRequest))
+		return
+	}
+
+	rq := &StarMessageRequest{
+		AccountId: c.account.Id,
+		MessageId: request.Data.MessageId,
+	}
+
+	var srvErr *system.Error
+	if request.Type == EventUnstarMessage {
+		_, srvErr = wsServer.UnstarMessage(rq)
+	} else {
+		_, srvErr = wsServer.StarMessage(rq)
+	}
+	if srvErr != nil {
+		app.E().SetError(srvErr)
+	}
+
+}
+
+func (e *Event) EventReportMessage(h *Hub, c *Session, clientRequest []byte) {
+
+	defer app.E().CatchPanic("EventReportMessage")
+
+	request := &WSReportMessageRequest{}
+	err := json.Unmarshal(clientRequest, request)
+	if err != nil {
+		app.E().SetError(system.UnmarshalRequestError1201(err, clientRequest))
+		return
+	}
+
+	_, srvErr := wsServer.ReportMessage(&ReportMessageRequest{
+		AccountId: c.account.Id,
+		MessageId: request.Data.MessageId,
+		Reason:    request.Data.Reason,
+	})
+	if srvErr != nil {
+		app.E().SetError(srvErr)
+	}
+
+}
+
 func (e *Event) EventEcho(h *Hub, c *Session, clientRequest []byte) {
 
 	defer app.E().CatchPanic("EventEcho")
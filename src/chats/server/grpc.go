@@ -34,4 +34,7 @@ func (ws *WsServer) Grpc() {
 func registration(ws *WsServer, s *grpc.Server) {
 	pb.RegisterRoomServer(s, &RoomGrpcService{ws: ws})
 	pb.RegisterAccountServer(s, &AccountGrpcService{ws: ws})
+	pb.RegisterQueueServer(s, &QueueGrpcService{ws: ws})
+	pb.RegisterRoleServer(s, &RoleGrpcService{ws: ws})
+	pb.RegisterAuditServer(s, &AuditGrpcService{ws: ws})
 }
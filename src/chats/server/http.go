@@ -14,6 +14,8 @@ type httpServer struct {
 	server *http.Server
 	wsUpgrader *websocket.Upgrader
 	roomService *RoomHttpService
+	accountService *AccountHttpService
+	auditService *AuditHttpService
 	webSocketService *WebSocketService
 }
 
@@ -41,6 +43,12 @@ func (ws *WsServer) init() *httpServer {
 		roomService: &RoomHttpService{
 			ws: ws,
 		},
+		accountService: &AccountHttpService{
+			ws: ws,
+		},
+		auditService: &AuditHttpService{
+			ws: ws,
+		},
 		webSocketService: &WebSocketService{
 			ws: ws,
 		},
@@ -48,6 +56,8 @@ func (ws *WsServer) init() *httpServer {
 
 	server.webSocketService.setRouting(router)
 	server.roomService.setRouting(router)
+	server.accountService.setRouting(router)
+	server.auditService.setRouting(router)
 
 	return server
 }
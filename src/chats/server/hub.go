@@ -12,6 +12,8 @@ import (
 
 type Hub struct {
 	sessions        map[uuid.UUID]*Session
+	// guards sessions, accountSessions, accounts and the account of a session
+	sessionMutex    sync.RWMutex
 	accountSessions map[uuid.UUID]*Session
 	accounts        map[uuid.UUID]bool
 	rooms           map[uuid.UUID]*Room
@@ -40,9 +42,11 @@ func (h *Hub) Run() {
 	for {
 		select {
 		case session := <-h.registerChan:
+			h.sessionMutex.Lock()
 			h.sessions[session.sessionId] = session
 			h.accountSessions[session.account.Id] = session
 			h.accounts[session.account.Id] = true
+			h.sessionMutex.Unlock()
 			app.L().Debug(">>> session register:", session.account.Id) //	TODO
 			h.checkConnectionStatus(session.account.Id, true)
 		case session := <-h.unregisterChan:
@@ -68,7 +72,12 @@ func (h *Hub) Run() {
 
 func (h *Hub) onSessionDisconnect(session *Session) {
 
-	if _, ok := h.sessions[session.sessionId]; ok {
+	h.sessionMutex.Lock()
+	_, ok := h.sessions[session.sessionId]
+	delete(h.sessions, session.sessionId)
+	h.sessionMutex.Unlock()
+
+	if ok {
 
 		app.L().Debugf("Session cleanup %s", session.sessionId)
 
@@ -78,36 +87,130 @@ func (h *Hub) onSessionDisconnect(session *Session) {
 			app.E().SetError(err)
 		}
 
-		delete(h.sessions, session.sessionId)
 		h.removeSessionFromRooms(session)
 		close(session.sendChan)
 
-		if !h.accountHasSessions(session.account) {
-			delete(h.accounts, session.account.Id)
-			delete(h.accountSessions, session.account.Id)
-		}
+		h.removeAccountWithoutSessions(session.account.Id)
 
 	}
 }
 
-func (h *Hub) accountHasSessions(account *Account) bool {
+// removeAccountWithoutSessions forgets the account if it has no more sessions on the node
+func (h *Hub) removeAccountWithoutSessions(accountId uuid.UUID) {
+	h.sessionMutex.Lock()
+	defer h.sessionMutex.Unlock()
+
 	for _, s := range h.sessions {
-		if s.account.Id == account.Id {
-			return true
+		if s.account.Id == accountId {
+			return
 		}
 	}
 
-	return false
+	delete(h.accounts, accountId)
+	delete(h.accountSessions, accountId)
+}
+
+// switchAccount moves the sessions to the account (e.g. when the accounts are merged)
+func (h *Hub) switchAccount(sessions []*Session, fromAccountId uuid.UUID, account *Account) {
+	h.sessionMutex.Lock()
+	for _, session := range sessions {
+		session.account = account
+		h.accountSessions[account.Id] = session
+		h.accounts[account.Id] = true
+	}
+	h.sessionMutex.Unlock()
+
+	h.removeAccountWithoutSessions(fromAccountId)
+}
+
+// getRoom retrieves the room loaded on the node
+func (h *Hub) getRoom(roomId uuid.UUID) (*Room, bool) {
+	h.roomMutex.Lock()
+	defer h.roomMutex.Unlock()
+
+	room, ok := h.rooms[roomId]
+	return room, ok
+}
+
+// getAccountSession retrieves the last connected session of the account
+func (h *Hub) getAccountSession(accountId uuid.UUID) (*Session, bool) {
+	h.sessionMutex.RLock()
+	defer h.sessionMutex.RUnlock()
+
+	session, ok := h.accountSessions[accountId]
+	return session, ok
+}
+
+// getAccountIds retrieves accounts connected to the node
+func (h *Hub) getAccountIds() []uuid.UUID {
+	h.sessionMutex.RLock()
+	defer h.sessionMutex.RUnlock()
+
+	accountIds := make([]uuid.UUID, 0, len(h.accounts))
+	for accountId := range h.accounts {
+		accountIds = append(accountIds, accountId)
+	}
+	return accountIds
 }
 
 func (h *Hub) removeSessionFromRooms(session *Session) {
-	for _, room := range session.rooms {
+	for _, room := range session.getRooms() {
 		room.removeSession(session)
 	}
 }
 
+// getAccountSessions retrieves all the sessions of the account (an account may be connected from several devices)
+func (h *Hub) getAccountSessions(accountId uuid.UUID) []*Session {
+	h.sessionMutex.RLock()
+	defer h.sessionMutex.RUnlock()
+
+	var sessions []*Session
+	for _, s := range h.sessions {
+		if s.account.Id == accountId {
+			sessions = append(sessions, s)
+		}
+	}
+	return sessions
+}
+
+// getSession retrieves the session connected to the node
+func (h *Hub) getSession(sessionId uuid.UUID) (*Session, bool) {
+	h.sessionMutex.RLock()
+	defer h.sessionMutex.RUnlock()
+
+	session, ok := h.sessions[sessionId]
+	return session, ok
+}
+
+// removeRooms unloads rooms (e.g. closed ones) from the hub and from all the sessions
+func (h *Hub) removeRooms(roomIds []uuid.UUID) {
+	h.roomMutex.Lock()
+	defer h.roomMutex.Unlock()
+
+	h.sessionMutex.RLock()
+	defer h.sessionMutex.RUnlock()
+
+	for _, roomId := range roomIds {
+		if room, ok := h.rooms[roomId]; ok {
+			for _, sessionId := range room.getRoomSessionIds() {
+				if session, ok := h.sessions[sessionId]; ok {
+					session.removeRoom(roomId)
+				}
+			}
+			delete(h.rooms, roomId)
+		}
+	}
+}
+
 func (h *Hub) removeAllSessions() {
+	h.sessionMutex.RLock()
+	sessions := make([]*Session, 0, len(h.sessions))
 	for _, session := range h.sessions {
+		sessions = append(sessions, session)
+	}
+	h.sessionMutex.RUnlock()
+
+	for _, session := range sessions {
 		h.onSessionDisconnect(session)
 	}
 }
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"chats/app"
+	r "chats/repository/room"
+	"time"
+)
+
+// messageSweeper periodically wipes out the content of expired messages
+func (ws *WsServer) messageSweeper() {
+
+	step := app.Instance.Env.MessageSweeperStep()
+
+	for {
+		ws.expireMessages()
+		time.Sleep(step)
+	}
+}
+
+func (ws *WsServer) expireMessages() {
+
+	defer app.E().CatchPanic("expireMessages")
+
+	items, err := r.CreateRepository(app.GetDB()).ExpireMessages(time.Now())
+	if err != nil {
+		app.E().SetError(err)
+		return
+	}
+
+	for i := range items {
+		ws.sendMessageWiped(EventMessageExpired, &items[i])
+	}
+
+	if len(items) > 0 {
+		app.L().Debugf("%d messages expired", len(items))
+	}
+}
+
+// sendMessageWiped notifies the accounts the message was visible to that its content is wiped out
+func (ws *WsServer) sendMessageWiped(eventType string, item *r.ExpiredMessage) {
+
+	message := &WSChatResponse{
+		Type: eventType,
+		Data: &WSMessageExpiredDataResponse{
+			RoomId:    item.RoomId,
+			MessageId: item.Id,
+		},
+	}
+
+	// private messages are known to the sender and the recipient only
+	if item.RecipientAccountId != nil {
+		ws.hub.SendMessageToRoom(&RoomMessage{AccountId: item.AccountId, Message: message})
+		ws.hub.SendMessageToRoom(&RoomMessage{AccountId: *item.RecipientAccountId, Message: message})
+		return
+	}
+
+	roomMessage := &RoomMessage{
+		RoomId:  item.RoomId,
+		Message: message,
+	}
+	if item.Visibility == MessageVisibilityRoles {
+		roomMessage.VisibleRoles = stringListFromString(item.VisibleRoles)
+	}
+
+	ws.hub.SendMessageToRoom(roomMessage)
+
+	// the wiped out message is unpinned (only public messages can be pinned)
+	if item.Pinned {
+		if err := ws.sendPinsChanged(item.RoomId); err != nil {
+			app.E().SetError(err)
+		}
+	}
+}
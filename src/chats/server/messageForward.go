@@ -0,0 +1,161 @@
+package server
+
+import (
+	"chats/app"
+	r "chats/repository/room"
+	"chats/system"
+	"encoding/json"
+	"math"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+const (
+	maxForwardMessages = 100
+)
+
+func forwardedFrom(messageId, roomId, accountId *uuid.UUID, createdAt *time.Time) *ForwardedFrom {
+
+	if messageId == nil {
+		return nil
+	}
+
+	result := &ForwardedFrom{MessageId: *messageId}
+	if roomId != nil {
+		result.RoomId = *roomId
+	}
+	if accountId != nil {
+		result.AccountId = *accountId
+	}
+	if createdAt != nil {
+		result.CreatedAt = *createdAt
+	}
+
+	return result
+}
+
+// getRoomSubscriber retrieves the account's active subscription to the room
+func getRoomSubscriber(roomId uuid.UUID, accountId uuid.UUID) (*r.RoomSubscriber, *system.Error) {
+
+	subscribers, err := r.CreateRepository(app.GetDB()).GetRoomSubscribers(roomId)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range subscribers {
+		if subscribers[i].AccountId == accountId && subscribers[i].UnsubscribeAt == nil {
+			return &subscribers[i], nil
+		}
+	}
+
+	return nil, system.SysErrf(nil, system.NotSubscribedAccountCode, nil, accountId.String(), roomId.String())
+}
+
+// ForwardMessages copies messages of the source room to the target room on behalf of the account
+// forwarded messages are sent as usual ones and keep the reference to the original message
+func (ws *WsServer) ForwardMessages(request *ForwardMessagesRequest) (*ForwardMessagesResponse, *system.Error) {
+
+	defer app.E().CatchPanic("ForwardMessages")
+
+	if request.AccountId == uuid.Nil || request.SourceRoomId == uuid.Nil || request.TargetRoomId == uuid.Nil || len(request.MessageIds) == 0 {
+		return nil, system.SysErr(nil, system.IncorrectRequestCode, nil)
+	}
+
+	if len(request.MessageIds) > maxForwardMessages {
+		return nil, system.SysErrf(nil, system.MessageForwardLimitCode, nil, maxForwardMessages)
+	}
+
+	source, err := getRoomSubscriber(request.SourceRoomId, request.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = getRoomSubscriber(request.TargetRoomId, request.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := r.CreateRepository(app.GetDB()).GetMessages(request.MessageIds)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[uuid.UUID]bool)
+	for _, m := range messages {
+		found[m.Id] = true
+	}
+	for _, id := range request.MessageIds {
+		if !found[id] {
+			return nil, system.SysErrf(nil, system.MessageNotFoundCode, nil, id.String())
+		}
+	}
+
+	sendRq := &SendChatMessagesRequest{
+		SenderAccountId: request.AccountId,
+		Type:            EventMessage,
+		Data:            SendChatMessagesDataRequest{Messages: []SendChatMessageDataRequest{}},
+	}
+
+	for i := range messages {
+		m := &messages[i]
+
+		// only messages the account can see in the history can be forwarded
+		if m.RoomId != request.SourceRoomId || m.ModeratedAt != nil || !messageVisibleTo(m, source) {
+			return nil, system.SysErrf(nil, system.MessageNotFoundCode, nil, m.Id.String())
+		}
+
+		// the visibility can't be carried over to another room, so private and roles-only messages aren't forwarded
+		if m.RecipientAccountId != nil || m.Visibility == MessageVisibilityRoles {
+			return nil, system.SysErrf(nil, system.MessageForwardNotAllowedCode, nil, m.Id.String())
+		}
+
+		if m.ExpiredAt != nil {
+			return nil, system.SysErrf(nil, system.MessageExpiredCode, nil, m.Id.String())
+		}
+
+		// the forwarded copy expires along with the original message
+		var ttl int64
+		if m.ExpiresAt != nil {
+			ttl = int64(math.Ceil(time.Until(*m.ExpiresAt).Seconds()))
+			if ttl <= 0 {
+				return nil, system.SysErrf(nil, system.MessageExpiredCode, nil, m.Id.String())
+			}
+		}
+
+		params := make(map[string]string)
+		if m.Params != "" {
+			if e := json.Unmarshal([]byte(m.Params), &params); e != nil {
+				return nil, system.SysErr(e, system.UnmarshallingErrorCode, nil)
+			}
+		}
+
+		// the reference always points to the very first message, even if it's forwarded again
+		from := forwardedFrom(m.ForwardedMessageId, m.ForwardedRoomId, m.ForwardedAccountId, m.ForwardedAt)
+		if from == nil {
+			from = &ForwardedFrom{
+				MessageId: m.Id,
+				RoomId:    m.RoomId,
+				AccountId: m.AccountId,
+				CreatedAt: m.CreatedAt,
+			}
+		}
+
+		sendRq.Data.Messages = append(sendRq.Data.Messages, SendChatMessageDataRequest{
+			RoomId:        request.TargetRoomId,
+			Type:          m.Type,
+			Text:          m.Message,
+			Params:        params,
+			Payload:       payloadToRaw(m.Payload),
+			FileId:        m.FileId,
+			ForwardedFrom: from,
+			Ttl:           ttl,
+		})
+	}
+
+	_, err = ws.SendChatMessages(sendRq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ForwardMessagesResponse{Errors: []ErrorResponse{}}, nil
+}
@@ -0,0 +1,268 @@
+package server
+
+import (
+	"chats/app"
+	r "chats/repository/room"
+	"chats/system"
+	"encoding/json"
+	uuid "github.com/satori/go.uuid"
+)
+
+// heldMessageData is stored for the held message
+// fields hidden from clients are kept, so the approved message is sent exactly as requested
+type heldMessageData struct {
+	Message       SendChatMessageDataRequest `json:"message"`
+	FileId        string                     `json:"fileId,omitempty"`
+	ForwardedFrom *ForwardedFrom             `json:"forwardedFrom,omitempty"`
+}
+
+// moderateMessage passes the message's text and texts of the payload (titles of cards, buttons, forms) through the moderation chain
+// masked texts of the payload are replaced in the item, the masked message's text is returned in the result
+func (ws *WsServer) moderateMessage(senderAccountId uuid.UUID, item *SendChatMessageDataRequest) (*ModerationResult, *system.Error) {
+
+	moderate := func(text string) (*ModerationResult, *system.Error) {
+		return ws.moderation.Moderate(&ModerationMessage{
+			RoomId:          item.RoomId,
+			SenderAccountId: senderAccountId,
+			Type:            item.Type,
+			Text:            text,
+			Lang:            item.Params[ModerationLangParam],
+		})
+	}
+
+	result, err := moderate(item.Text)
+	if err != nil || result.Decision == app.ModerationHold || result.Decision == app.ModerationReject {
+		return result, err
+	}
+
+	payload, texts := decodePayloadTexts(item.Type, item.Payload)
+
+	masked := false
+	for _, text := range texts {
+
+		textResult, err := moderate(*text)
+		if err != nil {
+			return nil, err
+		}
+
+		switch textResult.Decision {
+		case app.ModerationHold, app.ModerationReject:
+			return textResult, nil
+		case app.ModerationMask:
+			*text = textResult.Text
+			masked = true
+		}
+	}
+
+	if masked {
+		b, e := json.Marshal(payload)
+		if e != nil {
+			return nil, system.MarshalError1011(e, nil)
+		}
+		item.Payload = b
+		result.Decision = app.ModerationMask
+	}
+
+	return result, nil
+}
+
+// holdMessage stores the message until it's reviewed by a moderator
+func (ws *WsServer) holdMessage(senderAccountId uuid.UUID, item *SendChatMessageDataRequest, result *ModerationResult) (uuid.UUID, *system.Error) {
+
+	data := &heldMessageData{
+		Message:       *item,
+		FileId:        item.FileId,
+		ForwardedFrom: item.ForwardedFrom,
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return uuid.Nil, system.MarshalError1011(err, nil)
+	}
+
+	held := &r.HeldMessage{
+		Id:        system.Uuid(),
+		RoomId:    item.RoomId,
+		AccountId: senderAccountId,
+		Message:   string(b),
+		Filter:    result.Filter,
+		Reason:    result.Reason,
+	}
+
+	sysErr := r.CreateRepository(app.GetDB()).CreateHeldMessage(held)
+	if sysErr != nil {
+		return uuid.Nil, sysErr
+	}
+
+	app.L().Debugf("Message %s held by %s filter (%s)", held.Id, held.Filter, held.Reason)
+
+	ws.hub.SendMessageToRoom(&RoomMessage{
+		AccountId: senderAccountId,
+		Message: &WSChatResponse{
+			Type: EventMessageHeld,
+			Data: &WSMessageHeldDataResponse{
+				RoomId:          item.RoomId,
+				HeldMessageId:   held.Id,
+				ClientMessageId: item.ClientMessageId,
+			},
+		},
+	})
+
+	return held.Id, nil
+}
+
+func heldMessageDataFromModel(item *r.HeldMessage) (*heldMessageData, *system.Error) {
+
+	data := &heldMessageData{}
+	if err := json.Unmarshal([]byte(item.Message), data); err != nil {
+		return nil, system.SysErr(err, system.UnmarshallingErrorCode, []byte(item.Message))
+	}
+
+	return data, nil
+}
+
+// sendMessageRejected notifies the sender the message hasn't been sent
+func (ws *WsServer) sendMessageRejected(accountId uuid.UUID, roomId uuid.UUID, clientMessageId string, heldMessageId uuid.UUID, err *system.Error) {
+
+	ws.hub.SendMessageToRoom(&RoomMessage{
+		AccountId: accountId,
+		Message: &WSChatResponse{
+			Type: EventError,
+			Data: &WSErrorDataResponse{
+				Code:            err.Code,
+				Message:         err.Message,
+				RoomId:          roomId,
+				HeldMessageId:   heldMessageId,
+				ClientMessageId: clientMessageId,
+			},
+		},
+	})
+}
+
+// checkReviewer checks the initiator is allowed to review messages of the room
+func checkReviewer(roomId uuid.UUID, initiatorAccountId uuid.UUID) *system.Error {
+
+	if initiatorAccountId == uuid.Nil {
+		return nil
+	}
+
+	room, err := r.CreateRepository(app.GetDB()).GetRoom(roomId)
+	if err != nil {
+		return err
+	}
+
+	return checkInitiatorCapability(room, initiatorAccountId, CapabilityDeleteAny)
+}
+
+func (ws *WsServer) GetHeldMessages(request *GetHeldMessagesRequest) (*GetHeldMessagesResponse, *system.Error) {
+
+	defer app.E().CatchPanic("GetHeldMessages")
+
+	if request.RoomId == uuid.Nil {
+		return nil, system.SysErr(nil, system.IncorrectRequestCode, nil)
+	}
+
+	err := checkReviewer(request.RoomId, request.InitiatorAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := r.CreateRepository(app.GetDB()).GetHeldMessages(request.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHeldMessagesResponse{
+		Messages: []HeldMessage{},
+		Errors:   []ErrorResponse{},
+	}
+
+	for i := range items {
+		item := &items[i]
+
+		data, err := heldMessageDataFromModel(item)
+		if err != nil {
+			return nil, err
+		}
+
+		response.Messages = append(response.Messages, HeldMessage{
+			Id:        item.Id,
+			RoomId:    item.RoomId,
+			AccountId: item.AccountId,
+			Filter:    item.Filter,
+			Reason:    item.Reason,
+			CreatedAt: item.CreatedAt,
+			Message:   data.Message,
+		})
+	}
+
+	return response, nil
+}
+
+// ReviewHeldMessage sends the approved message on behalf of the sender or rejects it
+func (ws *WsServer) ReviewHeldMessage(request *ReviewHeldMessageRequest) (*ReviewHeldMessageResponse, *system.Error) {
+
+	defer app.E().CatchPanic("ReviewHeldMessage")
+
+	rep := r.CreateRepository(app.GetDB())
+
+	item, err := rep.GetHeldMessage(request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil {
+		return nil, system.SysErrf(nil, system.HeldMessageNotFoundCode, nil, request.Id.String())
+	}
+
+	err = checkReviewer(item.RoomId, request.InitiatorAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := heldMessageDataFromModel(item)
+	if err != nil {
+		return nil, err
+	}
+
+	// the message is removed beforehand, so it's never reviewed twice (it's restored if sending fails)
+	deleted, err := rep.DeleteHeldMessage(item.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !deleted {
+		return nil, system.SysErrf(nil, system.HeldMessageNotFoundCode, nil, request.Id.String())
+	}
+
+	if request.Approve {
+
+		message := data.Message
+		message.FileId = data.FileId
+		message.ForwardedFrom = data.ForwardedFrom
+
+		_, err = ws.SendChatMessages(&SendChatMessagesRequest{
+			SenderAccountId: item.AccountId,
+			Type:            EventMessage,
+			Data:            SendChatMessagesDataRequest{Messages: []SendChatMessageDataRequest{message}},
+			Moderated:       true,
+		})
+		if err != nil {
+			if restoreErr := rep.CreateHeldMessage(item); restoreErr != nil {
+				app.E().SetError(restoreErr)
+			}
+			return nil, err
+		}
+
+		writeAudit(request.InitiatorAccountId, AuditActionMessageApprove, AuditTargetMessage, item.Id, nil, nil)
+
+	} else {
+
+		ws.sendMessageRejected(item.AccountId, item.RoomId, data.Message.ClientMessageId, item.Id,
+			system.SysErrf(nil, system.MessageRejectedCode, nil, request.Reason))
+
+		writeAudit(request.InitiatorAccountId, AuditActionMessageReject, AuditTargetMessage, item.Id, nil, nil)
+	}
+
+	return &ReviewHeldMessageResponse{Errors: []ErrorResponse{}}, nil
+}
@@ -0,0 +1,314 @@
+package server
+
+import (
+	"bytes"
+	"chats/system"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	MessageTypeMessage      = "message"
+	MessageTypeFile         = "file"
+	MessageTypeButtons      = "buttons"
+	MessageTypeQuickReplies = "quickReplies"
+	MessageTypeCard         = "card"
+	MessageTypeForm         = "form"
+	// messages posted by the chat service itself (e.g. room transfer), clients cannot send them
+	MessageTypeSystem = "system"
+)
+
+const (
+	FormFieldTypeText     = "text"
+	FormFieldTypeTextArea = "textarea"
+	FormFieldTypeNumber   = "number"
+	FormFieldTypeEmail    = "email"
+	FormFieldTypePhone    = "phone"
+	FormFieldTypeDate     = "date"
+	FormFieldTypeSelect   = "select"
+)
+
+const (
+	maxMessageButtons = 10
+	maxFormFields     = 20
+)
+
+type MessageButton struct {
+	Id    string `json:"id"`
+	Title string `json:"title"`
+	// value passed back to the sender with buttonClick event (id is used if empty)
+	Value string `json:"value"`
+	// if populated, a client opens the link instead of sending buttonClick
+	Url string `json:"url"`
+}
+
+type ButtonsPayload struct {
+	Buttons []MessageButton `json:"buttons"`
+}
+
+type QuickRepliesPayload struct {
+	Replies []MessageButton `json:"replies"`
+}
+
+type CardPayload struct {
+	ImageUrl    string          `json:"imageUrl"`
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	Buttons     []MessageButton `json:"buttons"`
+}
+
+type FormField struct {
+	Name     string   `json:"name"`
+	Label    string   `json:"label"`
+	Type     string   `json:"type"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options"`
+}
+
+type FormPayload struct {
+	Title       string      `json:"title"`
+	Fields      []FormField `json:"fields"`
+	SubmitTitle string      `json:"submitTitle"`
+}
+
+func payloadErr(messageType string, reason string) *system.Error {
+	return system.SysErrf(nil, system.MessagePayloadInvalidCode, nil, messageType, reason)
+}
+
+func unmarshalPayload(payload json.RawMessage, target interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(target)
+}
+
+func validateButtons(messageType string, buttons []MessageButton, required bool) *system.Error {
+
+	if required && len(buttons) == 0 {
+		return payloadErr(messageType, "no buttons")
+	}
+
+	if len(buttons) > maxMessageButtons {
+		return payloadErr(messageType, fmt.Sprintf("too many buttons (max %d)", maxMessageButtons))
+	}
+
+	ids := make(map[string]bool)
+	for _, b := range buttons {
+		if b.Id == "" || b.Title == "" {
+			return payloadErr(messageType, "button id and title are mandatory")
+		}
+		if _, ok := ids[b.Id]; ok {
+			return payloadErr(messageType, fmt.Sprintf("duplicated button id %s", b.Id))
+		}
+		ids[b.Id] = true
+	}
+
+	return nil
+}
+
+func validateForm(form *FormPayload) *system.Error {
+
+	typesMap := map[string]bool{
+		FormFieldTypeText:     true,
+		FormFieldTypeTextArea: true,
+		FormFieldTypeNumber:   true,
+		FormFieldTypeEmail:    true,
+		FormFieldTypePhone:    true,
+		FormFieldTypeDate:     true,
+		FormFieldTypeSelect:   true,
+	}
+
+	if len(form.Fields) == 0 {
+		return payloadErr(MessageTypeForm, "no fields")
+	}
+
+	if len(form.Fields) > maxFormFields {
+		return payloadErr(MessageTypeForm, fmt.Sprintf("too many fields (max %d)", maxFormFields))
+	}
+
+	names := make(map[string]bool)
+	for _, f := range form.Fields {
+		if f.Name == "" {
+			return payloadErr(MessageTypeForm, "field name is mandatory")
+		}
+		if _, ok := names[f.Name]; ok {
+			return payloadErr(MessageTypeForm, fmt.Sprintf("duplicated field %s", f.Name))
+		}
+		names[f.Name] = true
+
+		if _, ok := typesMap[f.Type]; !ok {
+			return payloadErr(MessageTypeForm, fmt.Sprintf("field %s has incorrect type %s", f.Name, f.Type))
+		}
+		if f.Type == FormFieldTypeSelect && len(f.Options) == 0 {
+			return payloadErr(MessageTypeForm, fmt.Sprintf("field %s has no options", f.Name))
+		}
+	}
+
+	return nil
+}
+
+// validateMessagePayload checks the payload matches a schema of the message type
+func validateMessagePayload(messageType string, payload json.RawMessage) *system.Error {
+
+	empty := len(payload) == 0 || string(payload) == "null"
+
+	if !empty && len(payload) > maxMessageSize {
+		return system.SysErr(nil, system.MessageTooLongErrorCode, nil)
+	}
+
+	switch messageType {
+	case MessageTypeMessage, MessageTypeFile:
+		if !empty {
+			return system.SysErrf(nil, system.MessagePayloadNotAllowedCode, nil, messageType)
+		}
+		return nil
+	case MessageTypeButtons, MessageTypeQuickReplies, MessageTypeCard, MessageTypeForm:
+		if empty {
+			return payloadErr(messageType, "payload is empty")
+		}
+	default:
+		return system.SysErrf(nil, system.MessageTypeNotSupportedCode, nil, messageType)
+	}
+
+	switch messageType {
+	case MessageTypeButtons:
+		p := &ButtonsPayload{}
+		if err := unmarshalPayload(payload, p); err != nil {
+			return payloadErr(messageType, err.Error())
+		}
+		return validateButtons(messageType, p.Buttons, true)
+	case MessageTypeQuickReplies:
+		p := &QuickRepliesPayload{}
+		if err := unmarshalPayload(payload, p); err != nil {
+			return payloadErr(messageType, err.Error())
+		}
+		return validateButtons(messageType, p.Replies, true)
+	case MessageTypeCard:
+		p := &CardPayload{}
+		if err := unmarshalPayload(payload, p); err != nil {
+			return payloadErr(messageType, err.Error())
+		}
+		if p.Title == "" {
+			return payloadErr(messageType, "title is mandatory")
+		}
+		return validateButtons(messageType, p.Buttons, false)
+	case MessageTypeForm:
+		p := &FormPayload{}
+		if err := unmarshalPayload(payload, p); err != nil {
+			return payloadErr(messageType, err.Error())
+		}
+		return validateForm(p)
+	}
+
+	return nil
+}
+
+func buttonTexts(buttons []MessageButton) []*string {
+	var texts []*string
+	for i := range buttons {
+		texts = append(texts, &buttons[i].Title)
+	}
+	return texts
+}
+
+// decodePayloadTexts decodes the validated payload and collects the texts shown to the recipients
+// the texts are changed in place, so the payload can be encoded back
+func decodePayloadTexts(messageType string, payload json.RawMessage) (interface{}, []*string) {
+
+	if len(payload) == 0 || string(payload) == "null" {
+		return nil, nil
+	}
+
+	switch messageType {
+	case MessageTypeButtons:
+		p := &ButtonsPayload{}
+		if unmarshalPayload(payload, p) == nil {
+			return p, buttonTexts(p.Buttons)
+		}
+	case MessageTypeQuickReplies:
+		p := &QuickRepliesPayload{}
+		if unmarshalPayload(payload, p) == nil {
+			return p, buttonTexts(p.Replies)
+		}
+	case MessageTypeCard:
+		p := &CardPayload{}
+		if unmarshalPayload(payload, p) == nil {
+			return p, append([]*string{&p.Title, &p.Description}, buttonTexts(p.Buttons)...)
+		}
+	case MessageTypeForm:
+		p := &FormPayload{}
+		if unmarshalPayload(payload, p) == nil {
+			texts := []*string{&p.Title, &p.SubmitTitle}
+			for i := range p.Fields {
+				texts = append(texts, &p.Fields[i].Label)
+				for j := range p.Fields[i].Options {
+					texts = append(texts, &p.Fields[i].Options[j])
+				}
+			}
+			return p, texts
+		}
+	}
+
+	return nil, nil
+}
+
+// messagePayloadButtons retrieves clickable buttons of the stored message payload
+func messagePayloadButtons(messageType string, payload string) []MessageButton {
+
+	if payload == "" {
+		return nil
+	}
+
+	switch messageType {
+	case MessageTypeButtons:
+		p := &ButtonsPayload{}
+		if json.Unmarshal([]byte(payload), p) == nil {
+			return p.Buttons
+		}
+	case MessageTypeQuickReplies:
+		p := &QuickRepliesPayload{}
+		if json.Unmarshal([]byte(payload), p) == nil {
+			return p.Replies
+		}
+	case MessageTypeCard:
+		p := &CardPayload{}
+		if json.Unmarshal([]byte(payload), p) == nil {
+			return p.Buttons
+		}
+	}
+
+	return nil
+}
+
+func payloadToRaw(payload *string) json.RawMessage {
+	if payload == nil || *payload == "" {
+		return nil
+	}
+	return json.RawMessage(*payload)
+}
+
+func payloadFromRaw(payload json.RawMessage) *string {
+	if len(payload) == 0 || string(payload) == "null" {
+		return nil
+	}
+	result := string(payload)
+	return &result
+}
+
+// stringListToString stores the list as a JSON array (visible roles, room tags)
+func stringListToString(list []string) *string {
+	if len(list) == 0 {
+		return nil
+	}
+	b, _ := json.Marshal(list)
+	result := string(b)
+	return &result
+}
+
+func stringListFromString(list *string) []string {
+	if list == nil || *list == "" {
+		return nil
+	}
+	var result []string
+	_ = json.Unmarshal([]byte(*list), &result)
+	return result
+}
@@ -0,0 +1,241 @@
+package server
+
+import (
+	"chats/app"
+	r "chats/repository/room"
+	"chats/system"
+	uuid "github.com/satori/go.uuid"
+)
+
+// messageVisibleTo checks the message is visible to the subscriber by the same rules as the message history
+func messageVisibleTo(message *r.ChatMessage, subscriber *r.RoomSubscriber) bool {
+
+	if message.AccountId == subscriber.AccountId {
+		return true
+	}
+
+	if message.RecipientAccountId != nil && *message.RecipientAccountId != subscriber.AccountId {
+		return false
+	}
+
+	if message.Visibility == MessageVisibilityRoles {
+		return roleVisible(stringListFromString(message.VisibleRoles), subscriber.Role)
+	}
+
+	return true
+}
+
+// getVisibleMessage retrieves the message if the account is subscribed to the message's room and the message is visible to it
+func getVisibleMessage(messageId uuid.UUID, accountId uuid.UUID) (*r.ChatMessage, *system.Error) {
+
+	roomRep := r.CreateRepository(app.GetDB())
+
+	message, err := roomRep.GetMessage(messageId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the message deleted by a moderator is gone for the subscribers
+	if message == nil || message.ModeratedAt != nil {
+		return nil, system.SysErrf(nil, system.MessageNotFoundCode, nil, messageId.String())
+	}
+
+	subscribers, err := roomRep.GetRoomSubscribers(message.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range subscribers {
+		if subscribers[i].AccountId == accountId && subscribers[i].UnsubscribeAt == nil {
+			if !messageVisibleTo(message, &subscribers[i]) {
+				return nil, system.SysErrf(nil, system.MessageNotFoundCode, nil, messageId.String())
+			}
+			return message, nil
+		}
+	}
+
+	return nil, system.SysErrf(nil, system.NotSubscribedAccountCode, nil, accountId.String(), message.RoomId.String())
+}
+
+func pinnedMessagesFromModel(items []r.PinnedMessage) []PinnedMessage {
+
+	result := []PinnedMessage{}
+
+	for _, item := range items {
+		result = append(result, PinnedMessage{
+			MessageId:       item.MessageId,
+			Type:            item.Type,
+			Text:            item.Message,
+			SenderAccountId: item.SenderAccountId,
+			CreatedAt:       item.MessageCreatedAt,
+			PinnedBy:        item.PinnedBy,
+			PinnedAt:        item.PinnedAt,
+		})
+	}
+
+	return result
+}
+
+// getPinRoom retrieves the open room and checks the initiator is allowed to pin messages there
+func getPinRoom(roomId uuid.UUID, initiatorAccountId uuid.UUID) (*r.Room, *system.Error) {
+
+	rooms, err := r.CreateRepository(app.GetDB()).GetRooms(&r.GetRoomCriteria{
+		RoomId:          roomId,
+		WithSubscribers: initiatorAccountId != uuid.Nil,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rooms) == 0 {
+		return nil, system.SysErrf(nil, system.NoRoomFoundByIdCode, nil, roomId.String())
+	}
+
+	err = checkInitiatorCapability(&rooms[0], initiatorAccountId, CapabilityPin)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rooms[0], nil
+}
+
+// PinMessage pins the message visible to all subscribers in the room
+func (ws *WsServer) PinMessage(request *PinMessageRequest) (*PinMessageResponse, *system.Error) {
+
+	defer app.E().CatchPanic("PinMessage")
+
+	roomRep := r.CreateRepository(app.GetDB())
+
+	message, err := roomRep.GetMessage(request.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	if message == nil || message.RoomId != request.RoomId || message.ModeratedAt != nil {
+		return nil, system.SysErrf(nil, system.MessageNotFoundCode, nil, request.MessageId.String())
+	}
+
+	if message.ExpiredAt != nil {
+		return nil, system.SysErrf(nil, system.MessageExpiredCode, nil, message.Id.String())
+	}
+
+	// pinned messages are shown to everyone in the room
+	if message.RecipientAccountId != nil || message.Visibility == MessageVisibilityRoles {
+		return nil, system.SysErrf(nil, system.MessagePinNotAllowedCode, nil, message.Id.String())
+	}
+
+	room, err := getPinRoom(request.RoomId, request.InitiatorAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	pinned, err := roomRep.PinMessage(&r.RoomPinnedMessage{
+		Id:        system.Uuid(),
+		RoomId:    room.Id,
+		MessageId: message.Id,
+		AccountId: request.InitiatorAccountId,
+	}, app.Instance.Env.RoomPinsLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	if pinned {
+		err = ws.sendPinsChanged(room.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &PinMessageResponse{Errors: []ErrorResponse{}}, nil
+}
+
+func (ws *WsServer) UnpinMessage(request *PinMessageRequest) (*PinMessageResponse, *system.Error) {
+
+	defer app.E().CatchPanic("UnpinMessage")
+
+	room, err := getPinRoom(request.RoomId, request.InitiatorAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	roomRep := r.CreateRepository(app.GetDB())
+
+	unpinned, err := roomRep.UnpinMessage(room.Id, request.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	// an expired (or deleted by a moderator) message has been already unpinned, the client gets the actual list anyway
+	if !unpinned {
+		message, err := roomRep.GetMessage(request.MessageId)
+		if err != nil {
+			return nil, err
+		}
+		unpinned = message != nil && message.RoomId == room.Id && (message.ExpiredAt != nil || message.ModeratedAt != nil)
+	}
+
+	if unpinned {
+		err = ws.sendPinsChanged(room.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &PinMessageResponse{Errors: []ErrorResponse{}}, nil
+}
+
+// sendPinsChanged sends the actual list of pinned messages to the room
+func (ws *WsServer) sendPinsChanged(roomId uuid.UUID) *system.Error {
+
+	pins, err := r.CreateRepository(app.GetDB()).GetPinnedMessages([]uuid.UUID{roomId})
+	if err != nil {
+		return err
+	}
+
+	ws.hub.SendMessageToRoom(&RoomMessage{
+		RoomId: roomId,
+		Message: &WSChatResponse{
+			Type: EventPinsChanged,
+			Data: &WSPinsChangedDataResponse{
+				RoomId: roomId,
+				Pins:   pinnedMessagesFromModel(pins[roomId]),
+			},
+		},
+	})
+
+	return nil
+}
+
+// StarMessage bookmarks the message for the account
+func (ws *WsServer) StarMessage(request *StarMessageRequest) (*StarMessageResponse, *system.Error) {
+
+	defer app.E().CatchPanic("StarMessage")
+
+	message, err := getVisibleMessage(request.MessageId, request.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.CreateRepository(app.GetDB()).StarMessage(&r.ChatMessageStar{
+		Id:        system.Uuid(),
+		MessageId: message.Id,
+		AccountId: request.AccountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &StarMessageResponse{Errors: []ErrorResponse{}}, nil
+}
+
+func (ws *WsServer) UnstarMessage(request *StarMessageRequest) (*StarMessageResponse, *system.Error) {
+
+	defer app.E().CatchPanic("UnstarMessage")
+
+	err := r.CreateRepository(app.GetDB()).UnstarMessage(request.AccountId, request.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StarMessageResponse{Errors: []ErrorResponse{}}, nil
+}
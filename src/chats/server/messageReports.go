@@ -0,0 +1,293 @@
+package server
+
+import (
+	"chats/app"
+	"chats/repository"
+	r "chats/repository/room"
+	"chats/system"
+	uuid "github.com/satori/go.uuid"
+	"unicode/utf8"
+)
+
+const (
+	MessageReportDismiss = "dismiss"
+	MessageReportDelete  = "delete"
+	MessageReportLock    = "lock"
+)
+
+const (
+	MessageReportStatusOpen      = "open"
+	MessageReportStatusDismissed = "dismissed"
+	MessageReportStatusDeleted   = "deleted"
+	MessageReportStatusLocked    = "locked"
+)
+
+const (
+	defaultReportContextSize = 5
+	maxReportContextSize     = 50
+	maxReportReasonLength    = 500
+)
+
+// report statuses by resolutions
+var messageReportStatuses = map[string]string{
+	MessageReportDismiss: MessageReportStatusDismissed,
+	MessageReportDelete:  MessageReportStatusDeleted,
+	MessageReportLock:    MessageReportStatusLocked,
+}
+
+// ReportMessage stores the account's complaint about the message visible to it
+func (ws *WsServer) ReportMessage(request *ReportMessageRequest) (*ReportMessageResponse, *system.Error) {
+
+	defer app.E().CatchPanic("ReportMessage")
+
+	if utf8.RuneCountInString(request.Reason) > maxReportReasonLength {
+		return nil, system.SysErr(nil, system.IncorrectRequestCode, nil)
+	}
+
+	message, err := getVisibleMessage(request.MessageId, request.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	if message.AccountId == request.AccountId {
+		return nil, system.SysErr(nil, system.MessageReportOwnCode, nil)
+	}
+
+	if message.ExpiredAt != nil {
+		return nil, system.SysErrf(nil, system.MessageExpiredCode, nil, message.Id.String())
+	}
+
+	report := &r.MessageReport{
+		Id:        system.Uuid(),
+		MessageId: message.Id,
+		RoomId:    message.RoomId,
+		AccountId: request.AccountId,
+		Reason:    request.Reason,
+	}
+
+	created, err := r.CreateRepository(app.GetDB()).CreateMessageReport(report)
+	if err != nil {
+		return nil, err
+	}
+
+	if !created {
+		return nil, system.SysErrf(nil, system.MessageAlreadyReportedCode, nil, message.Id.String())
+	}
+
+	app.L().Debugf("Message %s reported by %s", message.Id, request.AccountId)
+
+	return &ReportMessageResponse{
+		ReportId: report.Id,
+		Errors:   []ErrorResponse{},
+	}, nil
+}
+
+func reportedMessageItemFromModel(item *r.ChatMessage) ReportedMessageItem {
+	return ReportedMessageItem{
+		Id:                 item.Id,
+		Type:               item.Type,
+		Text:               item.Message,
+		FileId:             item.FileId,
+		SenderAccountId:    item.AccountId,
+		RecipientAccountId: item.RecipientAccountId,
+		Expired:            item.ExpiredAt != nil,
+		Deleted:            item.ModeratedAt != nil,
+		CreatedAt:          item.CreatedAt,
+	}
+}
+
+// GetMessageReports retrieves the moderation queue: messages having open reports along with the reports and the context
+func (ws *WsServer) GetMessageReports(request *GetMessageReportsRequest) (*GetMessageReportsResponse, *system.Error) {
+
+	defer app.E().CatchPanic("GetMessageReports")
+
+	// the moderator of the room can see the room's queue only
+	if request.InitiatorAccountId != uuid.Nil && request.RoomId == uuid.Nil {
+		return nil, system.SysErr(nil, system.IncorrectRequestCode, nil)
+	}
+
+	err := checkReviewer(request.RoomId, request.InitiatorAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	contextSize := request.ContextSize
+	if contextSize <= 0 {
+		contextSize = defaultReportContextSize
+	}
+	if contextSize > maxReportContextSize {
+		contextSize = maxReportContextSize
+	}
+
+	pagingRq := &repository.PagingRequest{Index: 1, Size: 100}
+	if request.PagingRequest != nil {
+		if request.PagingRequest.Index > 0 {
+			pagingRq.Index = request.PagingRequest.Index
+		}
+		if request.PagingRequest.Size > 0 {
+			pagingRq.Size = request.PagingRequest.Size
+		}
+	}
+
+	rep := r.CreateRepository(app.GetDB())
+
+	messageIds, pagingRs, err := rep.GetReportedMessageIds(request.RoomId, pagingRq)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := rep.GetOpenMessageReports(messageIds)
+	if err != nil {
+		return nil, err
+	}
+
+	reportsByMessage := make(map[uuid.UUID][]MessageReport)
+	for _, report := range reports {
+		reportsByMessage[report.MessageId] = append(reportsByMessage[report.MessageId], MessageReport{
+			Id:        report.Id,
+			AccountId: report.AccountId,
+			Reason:    report.Reason,
+			CreatedAt: report.CreatedAt,
+		})
+	}
+
+	response := &GetMessageReportsResponse{
+		Messages: []ReportedMessage{},
+		Paging: &PagingResponse{
+			Total: pagingRs.Total,
+			Index: pagingRs.Index,
+		},
+		Errors: []ErrorResponse{},
+	}
+
+	for _, id := range messageIds {
+
+		message, err := rep.GetMessage(id)
+		if err != nil {
+			return nil, err
+		}
+
+		// the message has been purged by the retention policy
+		if message == nil {
+			continue
+		}
+
+		contextMessages, err := rep.GetMessageContext(message, contextSize)
+		if err != nil {
+			return nil, err
+		}
+
+		item := ReportedMessage{
+			RoomId:  message.RoomId,
+			Message: reportedMessageItemFromModel(message),
+			Reports: reportsByMessage[id],
+			Context: []ReportedMessageItem{},
+		}
+
+		for i := range contextMessages {
+			item.Context = append(item.Context, reportedMessageItemFromModel(&contextMessages[i]))
+		}
+
+		response.Messages = append(response.Messages, item)
+	}
+
+	return response, nil
+}
+
+// ResolveMessageReports closes all the open reports of the message with the moderator's decision
+// the message is wiped out or its sender is locked if required, the reporting accounts are notified of the outcome
+func (ws *WsServer) ResolveMessageReports(request *ResolveMessageReportsRequest) (*ResolveMessageReportsResponse, *system.Error) {
+
+	defer app.E().CatchPanic("ResolveMessageReports")
+
+	status, ok := messageReportStatuses[request.Resolution]
+	if !ok {
+		return nil, system.SysErrf(nil, system.MessageReportResolutionInvalidCode, nil, request.Resolution)
+	}
+
+	rep := r.CreateRepository(app.GetDB())
+
+	message, err := rep.GetMessage(request.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	if message == nil {
+		return nil, system.SysErrf(nil, system.MessageNotFoundCode, nil, request.MessageId.String())
+	}
+
+	err = checkReviewer(message.RoomId, request.InitiatorAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := rep.GetOpenMessageReports([]uuid.UUID{message.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(reports) == 0 {
+		return nil, system.SysErrf(nil, system.MessageReportsNotFoundCode, nil, message.Id.String())
+	}
+
+	switch request.Resolution {
+	case MessageReportDismiss:
+
+		writeAudit(request.InitiatorAccountId, AuditActionMessageDismiss, AuditTargetMessage, message.Id, nil, nil)
+
+	case MessageReportDelete:
+
+		wiped, err := rep.WipeMessage(message.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		if wiped != nil {
+			ws.sendMessageWiped(EventMessageDeleted, wiped)
+		}
+
+		writeAudit(request.InitiatorAccountId, AuditActionMessageDelete, AuditTargetMessage, message.Id, nil, nil)
+
+	case MessageReportLock:
+
+		_, err := ws.lockAccount(&LockAccountRequest{
+			AccountId:          AccountIdRequest{AccountId: message.AccountId},
+			InitiatorAccountId: request.InitiatorAccountId,
+		})
+		// the sender may have been already locked by another report
+		if err != nil && err.Code != system.AccountNotActiveCode {
+			return nil, err
+		}
+
+	}
+
+	var resolvedBy *uuid.UUID
+	if request.InitiatorAccountId != uuid.Nil {
+		resolvedBy = &request.InitiatorAccountId
+	}
+
+	resolved, err := rep.ResolveMessageReports(message.Id, status, resolvedBy, request.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, report := range resolved {
+		ws.hub.SendMessageToRoom(&RoomMessage{
+			AccountId: report.AccountId,
+			Message: &WSChatResponse{
+				Type: EventReportResolved,
+				Data: &WSReportResolvedDataResponse{
+					ReportId:   report.Id,
+					RoomId:     report.RoomId,
+					MessageId:  report.MessageId,
+					Resolution: request.Resolution,
+					Comment:    request.Comment,
+				},
+			},
+		})
+	}
+
+	app.L().Debugf("%d reports of message %s resolved (%s)", len(resolved), message.Id, request.Resolution)
+
+	return &ResolveMessageReportsResponse{Errors: []ErrorResponse{}}, nil
+}
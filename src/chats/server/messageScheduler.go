@@ -0,0 +1,213 @@
+package server
+
+import (
+	"chats/app"
+	r "chats/repository/room"
+	"chats/system"
+	"encoding/json"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// scheduleMessage stores the message to be sent at the requested time
+func scheduleMessage(senderAccountId uuid.UUID, item *SendChatMessageDataRequest) (uuid.UUID, *system.Error) {
+
+	sendAt := item.SendAt.Local()
+
+	// the stored request is sent as a usual one
+	message := *item
+	message.SendAt = nil
+
+	b, err := json.Marshal(message)
+	if err != nil {
+		return uuid.Nil, system.MarshalError1011(err, nil)
+	}
+
+	scheduled := &r.ScheduledMessage{
+		Id:        system.Uuid(),
+		RoomId:    item.RoomId,
+		AccountId: senderAccountId,
+		SendAt:    sendAt,
+		Message:   string(b),
+	}
+
+	sysErr := r.CreateRepository(app.GetDB()).CreateScheduledMessage(scheduled)
+	if sysErr != nil {
+		return uuid.Nil, sysErr
+	}
+
+	return scheduled.Id, nil
+}
+
+func scheduledMessageFromModel(item *r.ScheduledMessage) (*ScheduledMessage, *system.Error) {
+
+	result := &ScheduledMessage{
+		Id:        item.Id,
+		RoomId:    item.RoomId,
+		AccountId: item.AccountId,
+		SendAt:    item.SendAt,
+	}
+
+	if err := json.Unmarshal([]byte(item.Message), &result.Message); err != nil {
+		return nil, system.SysErr(err, system.UnmarshallingErrorCode, []byte(item.Message))
+	}
+
+	return result, nil
+}
+
+// messageScheduler periodically sends scheduled messages which are due
+func (ws *WsServer) messageScheduler() {
+
+	step := app.Instance.Env.MessageSchedulerStep()
+
+	for {
+		ws.sendDueMessages()
+		time.Sleep(step)
+	}
+}
+
+func (ws *WsServer) sendDueMessages() {
+
+	defer app.E().CatchPanic("sendDueMessages")
+
+	rep := r.CreateRepository(app.GetDB())
+
+	items, err := rep.GetDueScheduledMessages(time.Now())
+	if err != nil {
+		app.E().SetError(err)
+		return
+	}
+
+	for i := range items {
+		if err := ws.sendDueMessage(rep, &items[i]); err != nil {
+			app.E().SetError(err)
+			ws.sendScheduledMessageError(&items[i], err)
+		}
+	}
+}
+
+func (ws *WsServer) sendDueMessage(rep *r.Repository, item *r.ScheduledMessage) *system.Error {
+
+	// the message is removed beforehand, so it's never sent twice
+	deleted, err := rep.DeleteScheduledMessage(item.Id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return nil
+	}
+
+	message, err := scheduledMessageFromModel(item)
+	if err != nil {
+		return err
+	}
+
+	room, err := rep.GetRoom(item.RoomId)
+	if err != nil {
+		return err
+	}
+
+	if room.ClosedAt != nil {
+		return system.SysErrf(nil, system.ScheduledMessageRoomClosedCode, nil, item.RoomId.String())
+	}
+
+	// the message has been moderated when scheduled
+	_, err = ws.SendChatMessages(&SendChatMessagesRequest{
+		SenderAccountId: item.AccountId,
+		Type:            EventMessage,
+		Data:            SendChatMessagesDataRequest{Messages: []SendChatMessageDataRequest{message.Message}},
+		Moderated:       true,
+	})
+	if err != nil {
+		return err
+	}
+
+	app.L().Debugf("Scheduled message %s sent to room %s", item.Id, item.RoomId)
+
+	return nil
+}
+
+// sendScheduledMessageError notifies the sender the scheduled message has been dropped
+func (ws *WsServer) sendScheduledMessageError(item *r.ScheduledMessage, err *system.Error) {
+
+	ws.hub.SendMessageToRoom(&RoomMessage{
+		AccountId: item.AccountId,
+		Message: &WSChatResponse{
+			Type: EventError,
+			Data: &WSErrorDataResponse{
+				Code:               err.Code,
+				Message:            err.Message,
+				RoomId:             item.RoomId,
+				ScheduledMessageId: item.Id,
+			},
+		},
+	})
+}
+
+func (ws *WsServer) GetScheduledMessages(request *GetScheduledMessagesRequest) (*GetScheduledMessagesResponse, *system.Error) {
+
+	defer app.E().CatchPanic("GetScheduledMessages")
+
+	if request.RoomId == uuid.Nil {
+		return nil, system.SysErr(nil, system.IncorrectRequestCode, nil)
+	}
+
+	items, err := r.CreateRepository(app.GetDB()).GetScheduledMessages(request.RoomId, request.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetScheduledMessagesResponse{
+		Messages: []ScheduledMessage{},
+		Errors:   []ErrorResponse{},
+	}
+
+	for i := range items {
+		message, err := scheduledMessageFromModel(&items[i])
+		if err != nil {
+			return nil, err
+		}
+		response.Messages = append(response.Messages, *message)
+	}
+
+	return response, nil
+}
+
+func (ws *WsServer) CancelScheduledMessage(request *CancelScheduledMessageRequest) (*CancelScheduledMessageResponse, *system.Error) {
+
+	defer app.E().CatchPanic("CancelScheduledMessage")
+
+	rep := r.CreateRepository(app.GetDB())
+
+	item, err := rep.GetScheduledMessage(request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil {
+		return nil, system.SysErrf(nil, system.ScheduledMessageNotFoundCode, nil, request.Id.String())
+	}
+
+	// others' messages are cancelled by accounts able to delete any message
+	if request.InitiatorAccountId != uuid.Nil && request.InitiatorAccountId != item.AccountId {
+		room, err := rep.GetRoom(item.RoomId)
+		if err != nil {
+			return nil, err
+		}
+		err = checkInitiatorCapability(room, request.InitiatorAccountId, CapabilityDeleteAny)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	deleted, err := rep.DeleteScheduledMessage(item.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !deleted {
+		return nil, system.SysErrf(nil, system.ScheduledMessageNotFoundCode, nil, request.Id.String())
+	}
+
+	return &CancelScheduledMessageResponse{Errors: []ErrorResponse{}}, nil
+}
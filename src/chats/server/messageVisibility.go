@@ -0,0 +1,62 @@
+package server
+
+import (
+	"chats/system"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	// message is visible to all the room's subscribers
+	MessageVisibilityAll = "all"
+	// private message visible to the sender and the recipient only
+	MessageVisibilityRecipient = "recipient"
+	// internal note visible to subscribers with the listed roles only
+	MessageVisibilityRoles = "roles"
+)
+
+// messageVisibility validates the requested visibility scope of the message and retrieves the scope to be stored
+func messageVisibility(item *SendChatMessageDataRequest) (string, *system.Error) {
+
+	switch item.Visibility {
+	case "":
+		if item.RecipientAccountId != uuid.Nil {
+			return MessageVisibilityRecipient, nil
+		}
+		return MessageVisibilityAll, nil
+	case MessageVisibilityAll:
+		if item.RecipientAccountId != uuid.Nil {
+			return "", system.SysErrf(nil, system.MessageVisibilityInvalidCode, nil, item.Visibility)
+		}
+		return MessageVisibilityAll, nil
+	case MessageVisibilityRecipient:
+		if item.RecipientAccountId == uuid.Nil {
+			return "", system.SysErrf(nil, system.MessageVisibilityInvalidCode, nil, item.Visibility)
+		}
+		return MessageVisibilityRecipient, nil
+	case MessageVisibilityRoles:
+		if item.RecipientAccountId != uuid.Nil || len(item.VisibleRoles) == 0 {
+			return "", system.SysErrf(nil, system.MessageVisibilityInvalidCode, nil, item.Visibility)
+		}
+		return MessageVisibilityRoles, nil
+	}
+
+	return "", system.SysErrf(nil, system.MessageVisibilityInvalidCode, nil, item.Visibility)
+}
+
+// roleVisible checks if the role is among the roles the message is visible to
+// empty list means the message isn't restricted by roles
+func roleVisible(visibleRoles []string, role string) bool {
+
+	if len(visibleRoles) == 0 {
+		return true
+	}
+
+	for _, r := range visibleRoles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
@@ -0,0 +1,437 @@
+package server
+
+import (
+	"bytes"
+	"chats/app"
+	"chats/system"
+	"encoding/json"
+	"fmt"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	ModerationFilterWordlist = "wordlist"
+	ModerationFilterLinks    = "links"
+	ModerationFilterContacts = "contacts"
+	ModerationFilterHook     = "hook"
+)
+
+const (
+	// the message's param specifying the language of the text
+	ModerationLangParam = "lang"
+	// replaces masked links and contacts
+	ModerationMaskText = "***"
+)
+
+// ModerationMessage is a message passed through the moderation chain
+type ModerationMessage struct {
+	RoomId          uuid.UUID `json:"roomId"`
+	SenderAccountId uuid.UUID `json:"senderAccountId"`
+	Type            string    `json:"type"`
+	Text            string    `json:"text"`
+	Lang            string    `json:"lang,omitempty"`
+}
+
+// ModerationResult is a decision of a filter (or the whole chain)
+type ModerationResult struct {
+	// allow | mask | hold | reject
+	Decision string
+	// masked text
+	Text     string
+	// filter made the decision
+	Filter   string
+	Reason   string
+	// error returned to the sender if the message is rejected
+	Error    *system.Error
+}
+
+// ModerationFilter is a step of the moderation chain
+type ModerationFilter interface {
+	Name() string
+	Moderate(message *ModerationMessage) (*ModerationResult, *system.Error)
+}
+
+// ModerationFilterFactory builds the filter from the environment
+type ModerationFilterFactory func() (ModerationFilter, error)
+
+var moderationFilterFactories = map[string]ModerationFilterFactory{
+	ModerationFilterWordlist: newWordlistFilter,
+	ModerationFilterLinks:    newLinksFilter,
+	ModerationFilterContacts: newContactsFilter,
+	ModerationFilterHook:     newHookFilter,
+}
+
+// RegisterModerationFilter makes a custom filter available in MODERATION_FILTERS
+func RegisterModerationFilter(name string, factory ModerationFilterFactory) {
+	moderationFilterFactories[name] = factory
+}
+
+type ModerationChain struct {
+	filters []ModerationFilter
+}
+
+// newModerationChain builds filters listed in MODERATION_FILTERS in the given order
+// misconfigured filters are reported and skipped
+func newModerationChain() *ModerationChain {
+
+	chain := &ModerationChain{}
+
+	for _, name := range app.Instance.Env.ModerationFilters() {
+
+		factory, ok := moderationFilterFactories[name]
+		if !ok {
+			app.E().SetError(system.SysErrf(nil, system.ModerationFilterInvalidCode, nil, name, "unknown filter"))
+			continue
+		}
+
+		filter, err := factory()
+		if err != nil {
+			app.E().SetError(system.SysErrf(err, system.ModerationFilterInvalidCode, nil, name, err.Error()))
+			continue
+		}
+
+		chain.filters = append(chain.filters, filter)
+	}
+
+	return chain
+}
+
+// Moderate passes the message through the filters
+// masking filters change the text for the next ones, holding or rejecting filter stops the chain
+func (c *ModerationChain) Moderate(message *ModerationMessage) (*ModerationResult, *system.Error) {
+
+	result := &ModerationResult{Decision: app.ModerationAllow, Text: message.Text}
+
+	if c == nil || message.Text == "" {
+		return result, nil
+	}
+
+	for _, f := range c.filters {
+
+		filterResult, err := f.Moderate(message)
+		if err != nil {
+			return nil, err
+		}
+
+		filterResult.Filter = f.Name()
+
+		switch filterResult.Decision {
+		case app.ModerationMask:
+			message.Text = filterResult.Text
+			result.Decision = app.ModerationMask
+			result.Text = filterResult.Text
+		case app.ModerationHold, app.ModerationReject:
+			// the reviewer sees the text as it came to the filter
+			filterResult.Text = message.Text
+			if filterResult.Decision == app.ModerationReject && filterResult.Error == nil {
+				filterResult.Error = system.SysErrf(nil, system.MessageRejectedCode, nil, filterResult.Reason)
+			}
+			return filterResult, nil
+		}
+	}
+
+	return result, nil
+}
+
+// moderationDecision builds the filter's result for the found violations
+func moderationDecision(action string, text string, reason string, rejectErr *system.Error) *ModerationResult {
+	result := &ModerationResult{Decision: action, Text: text, Reason: reason}
+	if action == app.ModerationReject {
+		result.Error = rejectErr
+	}
+	return result
+}
+
+// maskWord replaces every letter of the word
+func maskWord(word string) string {
+	return strings.Repeat("*", utf8.RuneCountInString(word))
+}
+
+// wordlistFilter checks words of the text against patterns of the message's language (or all the languages)
+type wordlistFilter struct {
+	action string
+	lists  map[string][]*regexp.Regexp
+}
+
+var wordRegexp = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+func newWordlistFilter() (ModerationFilter, error) {
+
+	lists, err := app.Instance.Env.ModerationWordlists()
+	if err != nil {
+		return nil, err
+	}
+
+	f := &wordlistFilter{
+		action: app.Instance.Env.ModerationWordlistAction(),
+		lists:  make(map[string][]*regexp.Regexp),
+	}
+
+	for lang, patterns := range lists {
+		for _, p := range patterns {
+			// a pattern matches a whole word
+			re, err := regexp.Compile(`^(?i:` + p + `)$`)
+			if err != nil {
+				return nil, err
+			}
+			f.lists[lang] = append(f.lists[lang], re)
+		}
+	}
+
+	return f, nil
+}
+
+func (f *wordlistFilter) Name() string {
+	return ModerationFilterWordlist
+}
+
+func (f *wordlistFilter) patterns(lang string) []*regexp.Regexp {
+
+	if list, ok := f.lists[lang]; ok {
+		return list
+	}
+
+	var all []*regexp.Regexp
+	for _, list := range f.lists {
+		all = append(all, list...)
+	}
+	return all
+}
+
+func (f *wordlistFilter) Moderate(message *ModerationMessage) (*ModerationResult, *system.Error) {
+
+	patterns := f.patterns(message.Lang)
+	found := false
+
+	text := wordRegexp.ReplaceAllStringFunc(message.Text, func(word string) string {
+		for _, re := range patterns {
+			if re.MatchString(word) {
+				found = true
+				return maskWord(word)
+			}
+		}
+		return word
+	})
+
+	if !found {
+		return &ModerationResult{Decision: app.ModerationAllow}, nil
+	}
+
+	return moderationDecision(f.action, text, "profanity", system.SysErr(nil, system.MessageProfanityCode, nil)), nil
+}
+
+// linksFilter finds links to domains which aren't allowed
+type linksFilter struct {
+	action  string
+	allowed []string
+}
+
+var linkRegexp = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"]+`)
+
+func newLinksFilter() (ModerationFilter, error) {
+	return &linksFilter{
+		action:  app.Instance.Env.ModerationLinksAction(),
+		allowed: app.Instance.Env.ModerationLinksAllowed(),
+	}, nil
+}
+
+func (f *linksFilter) Name() string {
+	return ModerationFilterLinks
+}
+
+func (f *linksFilter) linkAllowed(link string) bool {
+
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, domain := range f.allowed {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *linksFilter) Moderate(message *ModerationMessage) (*ModerationResult, *system.Error) {
+
+	found := false
+
+	text := linkRegexp.ReplaceAllStringFunc(message.Text, func(link string) string {
+		if f.linkAllowed(link) {
+			return link
+		}
+		found = true
+		return ModerationMaskText
+	})
+
+	if !found {
+		return &ModerationResult{Decision: app.ModerationAllow}, nil
+	}
+
+	return moderationDecision(f.action, text, "links", system.SysErr(nil, system.MessageLinksNotAllowedCode, nil)), nil
+}
+
+// contactsFilter finds phone numbers and emails
+type contactsFilter struct {
+	action string
+}
+
+var (
+	emailRegexp = regexp.MustCompile(`[\p{L}\p{N}._%+\-]+@[\p{L}\p{N}.\-]+\.\p{L}{2,}`)
+	phoneRegexp = regexp.MustCompile(`\+?\d[\d\-\s().]{8,}\d`)
+	digitRegexp = regexp.MustCompile(`\d`)
+)
+
+func newContactsFilter() (ModerationFilter, error) {
+	return &contactsFilter{action: app.Instance.Env.ModerationContactsAction()}, nil
+}
+
+func (f *contactsFilter) Name() string {
+	return ModerationFilterContacts
+}
+
+func (f *contactsFilter) Moderate(message *ModerationMessage) (*ModerationResult, *system.Error) {
+
+	found := false
+
+	text := emailRegexp.ReplaceAllStringFunc(message.Text, func(string) string {
+		found = true
+		return ModerationMaskText
+	})
+
+	text = phoneRegexp.ReplaceAllStringFunc(text, func(phone string) string {
+		// dates, sums and other numbers are kept
+		digits := len(digitRegexp.FindAllString(phone, -1))
+		if digits < 10 || digits > 15 {
+			return phone
+		}
+		found = true
+		return ModerationMaskText
+	})
+
+	if !found {
+		return &ModerationResult{Decision: app.ModerationAllow}, nil
+	}
+
+	return moderationDecision(f.action, text, "contacts", system.SysErr(nil, system.MessageContactsNotAllowedCode, nil)), nil
+}
+
+// hookFilter asks the external moderation service via the webhook or NATS
+type hookFilter struct {
+	client  *http.Client
+	url     string
+	subject string
+}
+
+type moderationHookResponse struct {
+	// allow | mask | hold | reject
+	Decision string `json:"decision"`
+	// masked text (for mask)
+	Text     string `json:"text"`
+	Reason   string `json:"reason"`
+}
+
+func newHookFilter() (ModerationFilter, error) {
+
+	f := &hookFilter{
+		client:  &http.Client{Timeout: app.Instance.Env.ModerationHookTimeout()},
+		url:     app.Instance.Env.ModerationHookUrl(),
+		subject: app.Instance.Env.ModerationHookSubject(),
+	}
+
+	if f.url == "" && f.subject == "" {
+		return nil, fmt.Errorf("neither MODERATION_HOOK_URL nor MODERATION_HOOK_SUBJECT is set")
+	}
+
+	return f, nil
+}
+
+func (f *hookFilter) Name() string {
+	return ModerationFilterHook
+}
+
+func (f *hookFilter) request(data []byte) ([]byte, error) {
+
+	if f.url != "" {
+
+		rs, err := f.client.Post(f.url, "application/json", bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer rs.Body.Close()
+
+		if rs.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("webhook responded with status %d", rs.StatusCode)
+		}
+
+		buf := &bytes.Buffer{}
+		if _, err := buf.ReadFrom(rs.Body); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	msg, err := app.GetNats().Connection.Request(f.subject, data, app.Instance.Env.ModerationHookTimeout())
+	if err != nil {
+		return nil, err
+	}
+
+	return msg.Data, nil
+}
+
+// failed applies the configured action if the service isn't available
+func (f *hookFilter) failed(err error) *ModerationResult {
+
+	sysErr := system.SysErrf(err, system.ModerationHookErrorCode, nil, err.Error())
+	app.E().SetError(sysErr)
+
+	return moderationDecision(app.Instance.Env.ModerationHookFailAction(), "", err.Error(), sysErr)
+}
+
+func (f *hookFilter) Moderate(message *ModerationMessage) (*ModerationResult, *system.Error) {
+
+	data, err := json.Marshal(message)
+	if err != nil {
+		return nil, system.MarshalError1011(err, nil)
+	}
+
+	response, err := f.request(data)
+	if err != nil {
+		return f.failed(err), nil
+	}
+
+	rs := &moderationHookResponse{}
+	if err := json.Unmarshal(response, rs); err != nil {
+		return f.failed(err), nil
+	}
+
+	switch rs.Decision {
+	case app.ModerationAllow, "":
+		return &ModerationResult{Decision: app.ModerationAllow}, nil
+	case app.ModerationMask:
+		if rs.Text == "" {
+			return &ModerationResult{Decision: app.ModerationAllow}, nil
+		}
+		return &ModerationResult{Decision: app.ModerationMask, Text: rs.Text, Reason: rs.Reason}, nil
+	case app.ModerationHold:
+		return &ModerationResult{Decision: app.ModerationHold, Reason: rs.Reason}, nil
+	case app.ModerationReject:
+		return moderationDecision(app.ModerationReject, "", rs.Reason, system.SysErrf(nil, system.MessageRejectedCode, nil, rs.Reason)), nil
+	}
+
+	return f.failed(fmt.Errorf("unknown decision %s", rs.Decision)), nil
+}
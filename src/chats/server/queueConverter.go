@@ -0,0 +1,81 @@
+package server
+
+import (
+	"chats/proto"
+	"chats/system"
+	uuid "github.com/satori/go.uuid"
+)
+
+type QueueConverter struct{}
+
+func (c *QueueConverter) SetOperatorAvailabilityRequestFromProto(request *proto.SetOperatorAvailabilityRequest) (*SetOperatorAvailabilityRequest, *system.Error) {
+
+	result := &SetOperatorAvailabilityRequest{
+		Queue:     request.Queue,
+		Available: request.Available,
+	}
+
+	if request.Account != nil {
+		result.Account = AccountIdRequest{
+			AccountId:  request.Account.AccountId.ToUUID(),
+			ExternalId: request.Account.ExternalId,
+		}
+	}
+
+	return result, nil
+}
+
+func (c *QueueConverter) SetOperatorAvailabilityResponseProtoFromModel(response *SetOperatorAvailabilityResponse) (*proto.SetOperatorAvailabilityResponse, *system.Error) {
+
+	result := &proto.SetOperatorAvailabilityResponse{
+		Errors: ProtoErrorFromErrorRs(response.Errors),
+	}
+
+	return result, nil
+}
+
+func (c *QueueConverter) GetQueueRequestFromProto(request *proto.GetQueueRequest) (*GetQueueRequest, *system.Error) {
+
+	result := &GetQueueRequest{
+		Queue:        request.Queue,
+		WithAssigned: request.WithAssigned,
+	}
+
+	return result, nil
+}
+
+func (c *QueueConverter) GetQueueResponseProtoFromModel(response *GetQueueResponse) (*proto.GetQueueResponse, *system.Error) {
+
+	result := &proto.GetQueueResponse{
+		Items: []*proto.QueueItem{},
+		Stats: &proto.QueueStats{
+			Waiting:            int32(response.Stats.Waiting),
+			Assigned:           int32(response.Stats.Assigned),
+			AvailableOperators: int32(response.Stats.AvailableOperators),
+			AvgWaitingTime:     response.Stats.AvgWaitingTime,
+			MaxWaitingTime:     response.Stats.MaxWaitingTime,
+		},
+		Errors: ProtoErrorFromErrorRs(response.Errors),
+	}
+
+	for _, item := range response.Items {
+
+		assignedAccountId := uuid.Nil
+		if item.AssignedAccountId != nil {
+			assignedAccountId = *item.AssignedAccountId
+		}
+
+		result.Items = append(result.Items, &proto.QueueItem{
+			Id:                proto.FromUUID(item.Id),
+			Queue:             item.Queue,
+			RoomId:            proto.FromUUID(item.RoomId),
+			CreatedAt:         proto.ToTimestamp(&item.CreatedAt),
+			AssignedAccountId: proto.FromUUID(assignedAccountId),
+			AssignedAt:        proto.ToTimestamp(item.AssignedAt),
+			WaitingTime:       item.WaitingTime,
+			Transfers:         int32(item.Transfers),
+		})
+	}
+
+	return result, nil
+}
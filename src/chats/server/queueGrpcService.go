@@ -0,0 +1,61 @@
+package server
+
+import (
+	"chats/proto"
+	"context"
+)
+
+type QueueGrpcService struct {
+	ws *WsServer
+	proto.UnimplementedQueueServer
+}
+
+func (s *QueueGrpcService) SetOperatorAvailability(ctx context.Context, rq *proto.SetOperatorAvailabilityRequest) (*proto.SetOperatorAvailabilityResponse, error) {
+
+	errorRs := &proto.SetOperatorAvailabilityResponse{}
+	c := &QueueConverter{}
+	modelRq, err := c.SetOperatorAvailabilityRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.SetOperatorAvailability(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.SetOperatorAvailabilityResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *QueueGrpcService) GetQueue(ctx context.Context, rq *proto.GetQueueRequest) (*proto.GetQueueResponse, error) {
+
+	errorRs := &proto.GetQueueResponse{}
+	c := &QueueConverter{}
+	modelRq, err := c.GetQueueRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.GetQueue(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.GetQueueResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// role of the operator subscribed by the queue dispatcher
+const QueueOperatorRole = "operator"
+
+type SetOperatorAvailabilityRequest struct {
+	Queue     string           `json:"queue"`
+	Account   AccountIdRequest `json:"account"`
+	Available bool             `json:"available"`
+}
+
+type SetOperatorAvailabilityResponse struct {
+	Errors []ErrorResponse `json:"errors"`
+}
+
+type GetQueueRequest struct {
+	Queue string `json:"queue"`
+	// if true, items already assigned to an operator (of open rooms) are retrieved as well
+	WithAssigned bool `json:"withAssigned"`
+}
+
+type QueueItem struct {
+	Id                uuid.UUID  `json:"id"`
+	Queue             string     `json:"queue"`
+	RoomId            uuid.UUID  `json:"roomId"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	AssignedAccountId *uuid.UUID `json:"assignedAccountId"`
+	AssignedAt        *time.Time `json:"assignedAt"`
+	// seconds the room has been waiting (or had been waiting before assignment)
+	WaitingTime int64 `json:"waitingTime"`
+	Transfers   int   `json:"transfers"`
+}
+
+type QueueStats struct {
+	Waiting            int   `json:"waiting"`
+	Assigned           int   `json:"assigned"`
+	AvailableOperators int   `json:"availableOperators"`
+	AvgWaitingTime     int64 `json:"avgWaitingTime"`
+	MaxWaitingTime     int64 `json:"maxWaitingTime"`
+}
+
+type GetQueueResponse struct {
+	Items  []QueueItem     `json:"items"`
+	Stats  QueueStats      `json:"stats"`
+	Errors []ErrorResponse `json:"errors"`
+}
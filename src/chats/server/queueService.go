@@ -0,0 +1,239 @@
+package server
+
+import (
+	"chats/app"
+	a "chats/repository/account"
+	q "chats/repository/queue"
+	"chats/system"
+	"time"
+)
+
+func (ws *WsServer) SetOperatorAvailability(request *SetOperatorAvailabilityRequest) (*SetOperatorAvailabilityResponse, *system.Error) {
+
+	defer app.E().CatchPanic("SetOperatorAvailability")
+
+	if request.Queue == "" {
+		return nil, system.SysErr(nil, system.QueueNotSpecifiedCode, nil)
+	}
+
+	account, err := a.CreateRepository(app.GetDB()).GetAccount(request.Account.AccountId, request.Account.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Status != AccountStatusActive {
+		return nil, system.SysErrf(nil, system.AccountNotActiveCode, nil, account.Id.String())
+	}
+
+	err = q.CreateRepository(app.GetDB()).SetOperatorAvailability(request.Queue, account.Id, request.Available)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetOperatorAvailabilityResponse{
+		Errors: []ErrorResponse{},
+	}
+
+	return response, nil
+}
+
+func (ws *WsServer) GetQueue(request *GetQueueRequest) (*GetQueueResponse, *system.Error) {
+
+	defer app.E().CatchPanic("GetQueue")
+
+	if request.Queue == "" {
+		return nil, system.SysErr(nil, system.QueueNotSpecifiedCode, nil)
+	}
+
+	rep := q.CreateRepository(app.GetDB())
+
+	items, err := rep.GetItems(&q.GetQueueItemsCriteria{Queue: request.Queue, WithAssigned: request.WithAssigned})
+	if err != nil {
+		return nil, err
+	}
+
+	operators, err := rep.GetOperatorsLoad(request.Queue)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetQueueResponse{
+		Items: []QueueItem{},
+		Stats: QueueStats{
+			AvailableOperators: len(operators),
+		},
+		Errors: []ErrorResponse{},
+	}
+
+	now := time.Now()
+	var totalWaitingTime int64
+	for _, item := range items {
+
+		waitingTill := now
+		if item.AssignedAt != nil {
+			waitingTill = *item.AssignedAt
+			response.Stats.Assigned++
+		} else {
+			response.Stats.Waiting++
+		}
+		waitingTime := int64(waitingTill.Sub(item.CreatedAt).Seconds())
+
+		totalWaitingTime += waitingTime
+		if waitingTime > response.Stats.MaxWaitingTime {
+			response.Stats.MaxWaitingTime = waitingTime
+		}
+
+		response.Items = append(response.Items, QueueItem{
+			Id:                item.Id,
+			Queue:             item.Queue,
+			RoomId:            item.RoomId,
+			CreatedAt:         item.CreatedAt,
+			AssignedAccountId: item.AssignedAccountId,
+			AssignedAt:        item.AssignedAt,
+			WaitingTime:       waitingTime,
+			Transfers:         item.Transfers,
+		})
+	}
+
+	if len(items) > 0 {
+		response.Stats.AvgWaitingTime = totalWaitingTime / int64(len(items))
+	}
+
+	return response, nil
+}
+
+// queueDispatcher periodically assigns waiting rooms to available operators
+func (ws *WsServer) queueDispatcher() {
+
+	step := app.Instance.Env.QueueDispatchStep()
+
+	for {
+		ws.dispatchQueues()
+		time.Sleep(step)
+	}
+}
+
+func (ws *WsServer) dispatchQueues() {
+
+	defer app.E().CatchPanic("dispatchQueues")
+
+	rep := q.CreateRepository(app.GetDB())
+
+	queues, err := rep.GetWaitingQueues()
+	if err != nil {
+		app.E().SetError(err)
+		return
+	}
+
+	for _, queue := range queues {
+		err := ws.dispatchQueue(rep, queue)
+		if err != nil {
+			app.E().SetError(err)
+		}
+	}
+}
+
+func (ws *WsServer) dispatchQueue(rep *q.Repository, queue string) *system.Error {
+
+	items, err := rep.GetItems(&q.GetQueueItemsCriteria{Queue: queue})
+	if err != nil {
+		return err
+	}
+
+	operators, err := rep.GetOperatorsLoad(queue)
+	if err != nil {
+		return err
+	}
+
+	strategy := app.Instance.Env.QueueStrategy()
+	maxRooms := app.Instance.Env.QueueOperatorMaxRooms()
+
+	for _, item := range items {
+
+		operator := selectQueueOperator(operators, strategy, maxRooms)
+		if operator == nil {
+			app.L().Debugf("Queue %s. No operator available, %d room(s) are waiting", queue, len(items))
+			return nil
+		}
+
+		assigned, err := rep.AssignItem(item.Id, operator.AccountId)
+		if err != nil {
+			return err
+		}
+
+		// already taken by someone else
+		if !assigned {
+			continue
+		}
+
+		// the operator keeps the rooms assigned earlier
+		_, err = ws.RoomSubscribe(&RoomSubscribeRequest{
+			RoomId:        item.RoomId,
+			Internal:      true,
+			KeepOpenRooms: true,
+			Subscribers: []SubscriberRequest{
+				{
+					Account: &AccountIdRequest{AccountId: operator.AccountId},
+					Role:    QueueOperatorRole,
+				},
+			},
+		})
+		if err != nil {
+			app.E().SetError(err)
+			if err := rep.UnassignItem(item.Id); err != nil {
+				return err
+			}
+			continue
+		}
+
+		now := time.Now()
+		operator.Rooms++
+		operator.LastAssignedAt = &now
+
+		app.L().Debugf("Queue %s. Room %s assigned to operator %s", queue, item.RoomId, operator.AccountId)
+	}
+
+	return nil
+}
+
+// selectQueueOperator picks an operator according to the strategy
+// roundRobin - the operator who has been waiting for an assignment the longest
+// leastLoaded - the operator with the least number of open rooms
+func selectQueueOperator(operators []q.OperatorLoad, strategy string, maxRooms int) *q.OperatorLoad {
+
+	var selected *q.OperatorLoad
+
+	for i := range operators {
+
+		o := &operators[i]
+
+		if maxRooms > 0 && o.Rooms >= maxRooms {
+			continue
+		}
+
+		if selected == nil {
+			selected = o
+			continue
+		}
+
+		if strategy == app.QueueStrategyLeastLoaded && o.Rooms != selected.Rooms {
+			if o.Rooms < selected.Rooms {
+				selected = o
+			}
+			continue
+		}
+
+		if assignedEarlier(o.LastAssignedAt, selected.LastAssignedAt) {
+			selected = o
+		}
+	}
+
+	return selected
+}
+
+func assignedEarlier(t1, t2 *time.Time) bool {
+	if t1 == nil {
+		return t2 != nil
+	}
+	return t2 != nil && t1.Before(*t2)
+}
@@ -0,0 +1,142 @@
+package server
+
+import (
+	"chats/app"
+	"chats/system"
+	"github.com/go-redis/redis"
+	uuid "github.com/satori/go.uuid"
+	"math"
+	"strconv"
+	"time"
+)
+
+const (
+	rateLimitAccountKey    = "ratelimit:account:"
+	rateLimitRoomKey       = "ratelimit:room:"
+	rateLimitConnectionKey = "ratelimit:connection"
+)
+
+// tokenBucketScript takes a token from the bucket stored in Redis, so the limits are shared across nodes
+// returns 0 if the token is taken, otherwise the number of milliseconds until the next token is available
+// KEYS[1] - bucket; ARGV - rate (tokens per second), burst, current time (ms)
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+
+if tokens == nil or ts == nil then
+  tokens = burst
+  ts = now
+end
+
+if now > ts then
+  tokens = math.min(burst, tokens + (now - ts) * rate / 1000)
+  ts = now
+end
+
+local wait = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+else
+  wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+
+redis.call('HMSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(ts))
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+
+return wait
+`)
+
+type RateLimiter struct {
+	account    app.RateLimit
+	room       app.RateLimit
+	connection app.RateLimit
+}
+
+func newRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		account:    app.Instance.Env.AccountRateLimit(),
+		room:       app.Instance.Env.RoomRateLimit(),
+		connection: app.Instance.Env.ConnectionRateLimit(),
+	}
+}
+
+// take returns the time to wait for the next token if the bucket is empty (zero otherwise)
+// requests are allowed if Redis isn't available, so the chat keeps working
+func (l *RateLimiter) take(key string, limit app.RateLimit) time.Duration {
+
+	if l == nil || !limit.Enabled() {
+		return 0
+	}
+
+	// the clocks of the nodes are assumed to be synchronized
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+
+	wait, err := tokenBucketScript.Run(app.GetDB().Redis.Instance, []string{key}, limit.Rate, limit.Burst, now).Int64()
+	if err != nil {
+		app.E().SetError(system.SysErrf(err, system.RateLimitErrorCode, nil, err.Error()))
+		return 0
+	}
+
+	return time.Duration(wait) * time.Millisecond
+}
+
+// checkAccount takes a token for a WebSocket message of the account
+func (l *RateLimiter) checkAccount(accountId uuid.UUID) (time.Duration, *system.Error) {
+
+	wait := l.take(rateLimitAccountKey+accountId.String(), l.account)
+	if wait == 0 {
+		return 0, nil
+	}
+
+	return wait, system.SysErrf(nil, system.RateLimitAccountCode, nil, wait.Milliseconds())
+}
+
+// checkRoom takes a token for a message sent to the room
+func (l *RateLimiter) checkRoom(roomId uuid.UUID) (time.Duration, *system.Error) {
+
+	wait := l.take(rateLimitRoomKey+roomId.String(), l.room)
+	if wait == 0 {
+		return 0, nil
+	}
+
+	return wait, system.SysErrf(nil, system.RateLimitRoomCode, nil, roomId.String(), wait.Milliseconds())
+}
+
+// checkConnection takes a token for a new WebSocket connection to the cluster
+func (l *RateLimiter) checkConnection() (time.Duration, *system.Error) {
+
+	wait := l.take(rateLimitConnectionKey, l.connection)
+	if wait == 0 {
+		return 0, nil
+	}
+
+	return wait, system.SysErrf(nil, system.RateLimitConnectionCode, nil, wait.Milliseconds())
+}
+
+// sendRateLimited notifies the sender the message over the limit hasn't been sent
+func (ws *WsServer) sendRateLimited(accountId uuid.UUID, roomId uuid.UUID, clientMessageId string, wait time.Duration, err *system.Error) {
+
+	ws.hub.SendMessageToRoom(&RoomMessage{
+		AccountId: accountId,
+		Message: &WSChatResponse{
+			Type: EventError,
+			Data: &WSErrorDataResponse{
+				Code:            err.Code,
+				Message:         err.Message,
+				RoomId:          roomId,
+				ClientMessageId: clientMessageId,
+				RetryAfter:      wait.Milliseconds(),
+			},
+		},
+	})
+}
+
+// retryAfterHeader formats the wait time for the Retry-After header (whole seconds rounded up)
+func retryAfterHeader(wait time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(wait.Seconds())))
+}
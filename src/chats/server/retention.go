@@ -0,0 +1,126 @@
+package server
+
+import (
+	"chats/app"
+	r "chats/repository/room"
+	"chats/system"
+	"time"
+)
+
+// retentionPurger periodically deletes messages and statuses outdated by the retention policies and the outdated audit log
+func (ws *WsServer) retentionPurger() {
+
+	step := app.Instance.Env.RetentionPurgeStep()
+
+	for {
+		ws.purgeByRetention()
+		ws.purgeAuditLog()
+		time.Sleep(step)
+	}
+}
+
+func (ws *WsServer) purgeByRetention() {
+
+	defer app.E().CatchPanic("purgeByRetention")
+
+	policies, e := app.Instance.Env.RetentionPolicies()
+	if e != nil {
+		app.E().SetError(system.SysErr(e, system.UnmarshallingErrorCode, nil))
+		return
+	}
+
+	_, err := PurgeByRetention(policies, time.Now(), app.Instance.Env.RetentionDryRun())
+	if err != nil {
+		app.E().SetError(err)
+	}
+}
+
+// RetentionPurgeResult is the amount of data deleted (or to be deleted in the dry run mode)
+type RetentionPurgeResult struct {
+	Messages int64
+	Statuses int64
+}
+
+// PurgeByRetention applies the retention policies to the data created before now
+// in the dry run mode nothing is deleted, the amount of data to be deleted is reported only
+func PurgeByRetention(policies []app.RetentionPolicy, now time.Time, dryRun bool) (*RetentionPurgeResult, *system.Error) {
+
+	rep := r.CreateRepository(app.GetDB())
+	result := &RetentionPurgeResult{}
+
+	// the first matching policy is applied, so rooms of the previous policies are excluded
+	var previous []r.RetentionRoomFilter
+
+	for _, p := range policies {
+
+		filter := &r.RetentionRoomFilter{
+			RoomType:        p.RoomType,
+			ReferencePrefix: p.ReferencePrefix,
+			Except:          previous,
+		}
+
+		if p.MessagesDays > 0 {
+			total, err := purgeRetentionData(p, "messages", now.AddDate(0, 0, -p.MessagesDays), dryRun, filter, rep.CountMessagesToPurge, rep.PurgeMessages)
+			if err != nil {
+				return nil, err
+			}
+			result.Messages += total
+		}
+
+		if p.StatusesDays > 0 {
+			total, err := purgeRetentionData(p, "statuses", now.AddDate(0, 0, -p.StatusesDays), dryRun, filter, rep.CountMessageStatusesToPurge, rep.PurgeMessageStatuses)
+			if err != nil {
+				return nil, err
+			}
+			result.Statuses += total
+		}
+
+		previous = append(previous, r.RetentionRoomFilter{
+			RoomType:        p.RoomType,
+			ReferencePrefix: p.ReferencePrefix,
+		})
+	}
+
+	return result, nil
+}
+
+// purgeRetentionData deletes data in batches logging the progress, returns the amount of deleted data
+// in the dry run mode it only reports the amount of data to be deleted
+func purgeRetentionData(policy app.RetentionPolicy,
+	entity string,
+	before time.Time,
+	dryRun bool,
+	filter *r.RetentionRoomFilter,
+	count func(*r.RetentionRoomFilter, time.Time) (int64, *system.Error),
+	purge func(*r.RetentionRoomFilter, time.Time, int) (int64, *system.Error)) (int64, *system.Error) {
+
+	if dryRun {
+		total, err := count(filter, before)
+		if err != nil {
+			return 0, err
+		}
+		app.L().Infof("Retention (dry run) type: '%s', prefix: '%s': %d %s created before %s would be deleted",
+			policy.RoomType, policy.ReferencePrefix, total, entity, before.Format(time.RFC3339))
+		return total, nil
+	}
+
+	batchSize := app.Instance.Env.RetentionBatchSize()
+
+	var total int64
+	for {
+		deleted, err := purge(filter, before, batchSize)
+		if err != nil {
+			return total, err
+		}
+
+		total += deleted
+		if deleted > 0 {
+			app.L().Infof("Retention type: '%s', prefix: '%s': %d %s deleted (%d in total)",
+				policy.RoomType, policy.ReferencePrefix, deleted, entity, total)
+		}
+
+		if deleted < int64(batchSize) {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"chats/proto"
+	"chats/system"
+)
+
+type RoleConverter struct{}
+
+func (c *RoleConverter) GetRolesRequestFromProto(request *proto.GetRolesRequest) (*GetRolesRequest, *system.Error) {
+	return &GetRolesRequest{}, nil
+}
+
+func (c *RoleConverter) GetRolesResponseProtoFromModel(response *GetRolesResponse) (*proto.GetRolesResponse, *system.Error) {
+
+	result := &proto.GetRolesResponse{
+		Roles:  []*proto.SubscriberRole{},
+		Errors: ProtoErrorFromErrorRs(response.Errors),
+	}
+
+	for _, item := range response.Roles {
+		result.Roles = append(result.Roles, &proto.SubscriberRole{
+			Code:         item.Code,
+			Description:  item.Description,
+			Capabilities: item.Capabilities,
+		})
+	}
+
+	return result, nil
+}
+
+func (c *RoleConverter) SetRoleRequestFromProto(request *proto.SetRoleRequest) (*SetRoleRequest, *system.Error) {
+
+	result := &SetRoleRequest{}
+
+	if request.Role != nil {
+		result.Role = Role{
+			Code:         request.Role.Code,
+			Description:  request.Role.Description,
+			Capabilities: request.Role.Capabilities,
+		}
+	}
+
+	return result, nil
+}
+
+func (c *RoleConverter) SetRoleResponseProtoFromModel(response *SetRoleResponse) (*proto.SetRoleResponse, *system.Error) {
+	return &proto.SetRoleResponse{
+		Errors: ProtoErrorFromErrorRs(response.Errors),
+	}, nil
+}
+
+func (c *RoleConverter) DeleteRoleRequestFromProto(request *proto.DeleteRoleRequest) (*DeleteRoleRequest, *system.Error) {
+	return &DeleteRoleRequest{
+		Code: request.Code,
+	}, nil
+}
+
+func (c *RoleConverter) DeleteRoleResponseProtoFromModel(response *DeleteRoleResponse) (*proto.DeleteRoleResponse, *system.Error) {
+	return &proto.DeleteRoleResponse{
+		Errors: ProtoErrorFromErrorRs(response.Errors),
+	}, nil
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"chats/proto"
+	"context"
+)
+
+type RoleGrpcService struct {
+	ws *WsServer
+	proto.UnimplementedRoleServer
+}
+
+func (s *RoleGrpcService) GetRoles(ctx context.Context, rq *proto.GetRolesRequest) (*proto.GetRolesResponse, error) {
+
+	errorRs := &proto.GetRolesResponse{}
+	c := &RoleConverter{}
+	modelRq, err := c.GetRolesRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.GetRoles(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.GetRolesResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoleGrpcService) SetRole(ctx context.Context, rq *proto.SetRoleRequest) (*proto.SetRoleResponse, error) {
+
+	errorRs := &proto.SetRoleResponse{}
+	c := &RoleConverter{}
+	modelRq, err := c.SetRoleRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.SetRole(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.SetRoleResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoleGrpcService) DeleteRole(ctx context.Context, rq *proto.DeleteRoleRequest) (*proto.DeleteRoleResponse, error) {
+
+	errorRs := &proto.DeleteRoleResponse{}
+	c := &RoleConverter{}
+	modelRq, err := c.DeleteRoleRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.DeleteRole(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.DeleteRoleResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
@@ -0,0 +1,67 @@
+package server
+
+const (
+	CapabilitySend        = "send"
+	CapabilitySendPrivate = "send-private"
+	CapabilityEditAny     = "edit-any"
+	CapabilityDeleteAny   = "delete-any"
+	CapabilityInvite      = "invite"
+	CapabilityKick        = "kick"
+	CapabilityClose       = "close"
+	CapabilityEditRoom    = "edit-room"
+	CapabilityPin         = "pin"
+	CapabilityPost        = "post"
+)
+
+const (
+	RoleMember   = "member"
+	RoleObserver = "observer"
+)
+
+var capabilities = []string{
+	CapabilitySend,
+	CapabilitySendPrivate,
+	CapabilityEditAny,
+	CapabilityDeleteAny,
+	CapabilityInvite,
+	CapabilityKick,
+	CapabilityClose,
+	CapabilityEditRoom,
+	CapabilityPin,
+	CapabilityPost,
+}
+
+// capabilities of subscribers without a role (subscribed before the roles were introduced)
+var defaultCapabilities = []string{
+	CapabilitySend,
+	CapabilitySendPrivate,
+}
+
+type Role struct {
+	Code         string   `json:"code"`
+	Description  string   `json:"description"`
+	Capabilities []string `json:"capabilities"`
+}
+
+type GetRolesRequest struct{}
+
+type GetRolesResponse struct {
+	Roles  []Role          `json:"roles"`
+	Errors []ErrorResponse `json:"errors"`
+}
+
+type SetRoleRequest struct {
+	Role Role `json:"role"`
+}
+
+type SetRoleResponse struct {
+	Errors []ErrorResponse `json:"errors"`
+}
+
+type DeleteRoleRequest struct {
+	Code string `json:"code"`
+}
+
+type DeleteRoleResponse struct {
+	Errors []ErrorResponse `json:"errors"`
+}
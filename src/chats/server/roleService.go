@@ -0,0 +1,195 @@
+package server
+
+import (
+	"chats/app"
+	r "chats/repository/room"
+	rl "chats/repository/role"
+	"chats/system"
+	uuid "github.com/satori/go.uuid"
+)
+
+// roleCapabilities retrieves capabilities granted to the role
+// returns false if the role isn't registered
+func roleCapabilities(role string) ([]string, bool, *system.Error) {
+
+	if role == "" {
+		return defaultCapabilities, true, nil
+	}
+
+	roles, err := rl.CreateRepository(app.GetDB()).GetRoles()
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, item := range roles {
+		if item.Code == role {
+			return item.Capabilities, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+func hasCapability(role string, capability string) (bool, *system.Error) {
+
+	roleCaps, _, err := roleCapabilities(role)
+	if err != nil {
+		return false, err
+	}
+
+	for _, c := range roleCaps {
+		if c == capability {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// subscriberRole retrieves the requested role of the subscriber, observers get the observer role by default
+func subscriberRole(s SubscriberRequest) string {
+	if s.Role == "" && s.AsObserver {
+		return RoleObserver
+	}
+	return s.Role
+}
+
+func checkRoleExists(role string) *system.Error {
+
+	_, found, err := roleCapabilities(role)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return system.SysErrf(nil, system.RoleNotFoundCode, nil, role)
+	}
+
+	return nil
+}
+
+// checkRolesExist checks all the subscribers' roles are registered
+func checkRolesExist(subscribers []SubscriberRequest) *system.Error {
+
+	for _, s := range subscribers {
+		if err := checkRoleExists(subscriberRole(s)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkSubscriberCapability checks the role of the account subscribed on the room grants the capability
+func checkSubscriberCapability(roomId uuid.UUID, accountId uuid.UUID, role string, capability string) *system.Error {
+
+	granted, err := hasCapability(role, capability)
+	if err != nil {
+		return err
+	}
+
+	if !granted {
+		return system.SysErrf(nil, system.CapabilityDeniedCode, nil, accountId.String(), capability, roomId.String())
+	}
+
+	return nil
+}
+
+// checkInitiatorCapability checks the initiator of the request is an active subscriber of the room having the capability
+// if no initiator is passed, the request comes from a trusted service and isn't checked
+func checkInitiatorCapability(room *r.Room, initiatorAccountId uuid.UUID, capability string) *system.Error {
+
+	if initiatorAccountId == uuid.Nil {
+		return nil
+	}
+
+	for _, s := range room.Subscribers {
+		if s.AccountId == initiatorAccountId && s.UnsubscribeAt == nil {
+			return checkSubscriberCapability(room.Id, initiatorAccountId, s.Role, capability)
+		}
+	}
+
+	return system.SysErrf(nil, system.NotSubscribedAccountCode, nil, initiatorAccountId.String(), room.Id.String())
+}
+
+func (ws *WsServer) GetRoles(request *GetRolesRequest) (*GetRolesResponse, *system.Error) {
+
+	defer app.E().CatchPanic("GetRoles")
+
+	roles, err := rl.CreateRepository(app.GetDB()).GetRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRolesResponse{
+		Roles:  []Role{},
+		Errors: []ErrorResponse{},
+	}
+
+	for _, item := range roles {
+		response.Roles = append(response.Roles, Role{
+			Code:         item.Code,
+			Description:  item.Description,
+			Capabilities: item.Capabilities,
+		})
+	}
+
+	return response, nil
+}
+
+func (ws *WsServer) SetRole(request *SetRoleRequest) (*SetRoleResponse, *system.Error) {
+
+	defer app.E().CatchPanic("SetRole")
+
+	if request.Role.Code == "" {
+		return nil, system.SysErr(nil, system.IncorrectRequestCode, nil)
+	}
+
+	for _, c := range request.Role.Capabilities {
+		supported := false
+		for _, item := range capabilities {
+			if item == c {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return nil, system.SysErrf(nil, system.CapabilityNotSupportedCode, nil, c)
+		}
+	}
+
+	err := rl.CreateRepository(app.GetDB()).SetRole(&rl.SubscriberRole{
+		Code:         request.Role.Code,
+		Description:  request.Role.Description,
+		Capabilities: request.Role.Capabilities,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetRoleResponse{
+		Errors: []ErrorResponse{},
+	}
+
+	return response, nil
+}
+
+func (ws *WsServer) DeleteRole(request *DeleteRoleRequest) (*DeleteRoleResponse, *system.Error) {
+
+	defer app.E().CatchPanic("DeleteRole")
+
+	found, err := rl.CreateRepository(app.GetDB()).DeleteRole(request.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, system.SysErrf(nil, system.RoleNotFoundCode, nil, request.Code)
+	}
+
+	response := &DeleteRoleResponse{
+		Errors: []ErrorResponse{},
+	}
+
+	return response, nil
+}
@@ -19,6 +19,8 @@ type RoomMessage struct {
 	AccountId uuid.UUID
 	RoomId    uuid.UUID
 	Message   *WSChatResponse
+	// if populated, the room's message is delivered to subscribers with the roles only
+	VisibleRoles []string
 }
 
 func InitRoom(roomId uuid.UUID, subscribers []r.AccountSubscriber) *Room {
@@ -58,6 +60,24 @@ func (r *Room) getRoomSessionIds() []uuid.UUID {
 	return sessionIds
 }
 
+func (r *Room) getSubscribers() []r.AccountSubscriber {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.subscribers
+}
+
+// getSubscriber retrieves the room's subscriber by the account
+func (room *Room) getSubscriber(accountId uuid.UUID) (r.AccountSubscriber, bool) {
+	room.mutex.Lock()
+	defer room.mutex.Unlock()
+	for _, s := range room.subscribers {
+		if s.AccountId == accountId {
+			return s, true
+		}
+	}
+	return r.AccountSubscriber{}, false
+}
+
 func (r *Room) UpdateSubscribers(subscribers []r.AccountSubscriber) {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"chats/app"
+	r "chats/repository/room"
+	"chats/system"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+const (
+	RoomCloseReasonManual     = "manual"
+	RoomCloseReasonNewRoom    = "newRoom"
+	RoomCloseReasonInactivity = "inactivity"
+	RoomCloseReasonScheduled  = "scheduled"
+)
+
+const (
+	RoomCloseWarningText = "Комната будет закрыта"
+	EventRoomCloseWarning = "roomCloseWarning"
+	RoomReopenedText = "Комната открыта повторно"
+	EventRoomReopened = "roomReopened"
+)
+
+// roomCloser periodically closes rooms by the schedule or inactivity and archives old closed rooms
+func (ws *WsServer) roomCloser() {
+
+	step := app.Instance.Env.RoomCloserStep()
+
+	for {
+		ws.closeDueRooms()
+		time.Sleep(step)
+	}
+}
+
+func (ws *WsServer) closeDueRooms() {
+
+	defer app.E().CatchPanic("closeDueRooms")
+
+	rep := r.CreateRepository(app.GetDB())
+
+	items, err := rep.GetRoomsToClose(time.Now(), app.Instance.Env.RoomCloseWarning())
+	if err != nil {
+		app.E().SetError(err)
+		return
+	}
+
+	for _, item := range items {
+		if err := ws.closeDueRoom(rep, item); err != nil {
+			app.E().SetError(err)
+		}
+	}
+
+	if archiveAfter := app.Instance.Env.RoomArchiveAfter(); archiveAfter > 0 {
+		count, err := rep.ArchiveClosedRooms(time.Now().Add(-archiveAfter))
+		if err != nil {
+			app.E().SetError(err)
+			return
+		}
+		if count > 0 {
+			app.L().Debugf("%d closed rooms archived", count)
+		}
+	}
+}
+
+func (ws *WsServer) closeDueRoom(rep *r.Repository, item r.RoomToClose) *system.Error {
+
+	reason := RoomCloseReasonInactivity
+	if item.Scheduled {
+		reason = RoomCloseReasonScheduled
+	}
+
+	if item.Due {
+
+		err := rep.CloseRoom(item.Id)
+		if err != nil {
+			return err
+		}
+
+		app.L().Debugf("Room %s closed (%s)", item.Id, reason)
+
+		ws.sendRoomClosedMessage([]uuid.UUID{item.Id}, reason)
+		auditRoomsClosed(uuid.Nil, []uuid.UUID{item.Id}, reason)
+		return nil
+	}
+
+	if item.Warn {
+
+		err := rep.SetRoomCloseWarned(item.Id)
+		if err != nil {
+			return err
+		}
+
+		return ws.sendRoomSystemMessage(item.Id, RoomCloseWarningText, map[string]string{
+			"event":   EventRoomCloseWarning,
+			"reason":  reason,
+			"closeAt": item.CloseAt.Format(time.RFC3339),
+		})
+	}
+
+	return nil
+}
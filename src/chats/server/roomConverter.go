@@ -3,6 +3,7 @@ package server
 import (
 	"chats/proto"
 	"chats/system"
+	"encoding/json"
 	uuid "github.com/satori/go.uuid"
 )
 
@@ -15,6 +16,19 @@ func (r *RoomConverter) CreateRequestFromProto(request *proto.CreateRoomRequest)
 			Chat:        request.Chat,
 			Video:       request.Video,
 			Audio:       request.Audio,
+			Queue:       request.Queue,
+			Type:        request.Type,
+			HashTtl:     request.HashTtl,
+			HashOneTime: request.HashOneTime,
+			InactivityTimeout: request.InactivityTimeout,
+			MessageTtl:  request.MessageTtl,
+			CloseAt:     request.CloseAt.ToTime(),
+			Title:       request.Title,
+			Description: request.Description,
+			AvatarUrl:   request.AvatarUrl,
+			Attributes:  payloadToRaw(&request.Attributes),
+			Tags:        request.Tags,
+			InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
 		},
 	}
 
@@ -28,6 +42,7 @@ func (r *RoomConverter) CreateRequestFromProto(request *proto.CreateRoomRequest)
 			},
 			Role: item.Role,
 			AsSystemAccount: item.AsSystemAccount,
+			AsObserver: item.AsObserver,
 		})
 	}
 
@@ -54,9 +69,10 @@ func (r *RoomConverter) CreateResponseProtoFromModel(request *CreateRoomResponse
 func (r *RoomConverter) SubscribeRequestFromProto(request *proto.RoomSubscribeRequest) (*RoomSubscribeRequest, *system.Error) {
 
 	result := &RoomSubscribeRequest{
-		RoomId:      request.RoomId.ToUUID(),
-		ReferenceId: request.ReferenceId,
-		Subscribers: []SubscriberRequest{},
+		RoomId:             request.RoomId.ToUUID(),
+		ReferenceId:        request.ReferenceId,
+		Subscribers:        []SubscriberRequest{},
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
 	}
 
 	for _, item := range request.Subscribers {
@@ -67,6 +83,7 @@ func (r *RoomConverter) SubscribeRequestFromProto(request *proto.RoomSubscribeRe
 			},
 			Role: item.Role,
 			AsSystemAccount: item.AsSystemAccount,
+			AsObserver: item.AsObserver,
 		})
 	}
 
@@ -99,6 +116,8 @@ func (r *RoomConverter) GetByCriteriaRequestFromProto(request *proto.GetRoomsByC
 	result.ReferenceId = request.ReferenceId
 	result.RoomId = request.RoomId.ToUUID()
 	result.WithClosed = request.WithClosed
+	result.WithArchived = request.WithArchived
+	result.Tags = request.Tags
 	result.WithSubscribers = request.WithSubscribers
 
 	return result, nil
@@ -120,7 +139,98 @@ func (r *RoomConverter) GetByCriteriaResponseProtoFromModel(request *GetRoomsByC
 			Video:       item.Video,
 			Audio:       item.Audio,
 			ClosedAt:    proto.ToTimestamp(item.ClosedAt),
+			ArchivedAt:  proto.ToTimestamp(item.ArchivedAt),
+			Title:       item.Title,
+			Description: item.Description,
+			AvatarUrl:   item.AvatarUrl,
+			Attributes:  string(item.Attributes),
+			Tags:        item.Tags,
+			MessageTtl:  item.MessageTtl,
+			Queue:       item.Queue,
+			Type:        item.Type,
 			Subscribers: []*proto.GetSubscriberResponse{},
+			Pins:        []*proto.PinnedMessage{},
+		}
+
+		for _, s := range item.Subscribers {
+			room.Subscribers = append(room.Subscribers, &proto.GetSubscriberResponse{
+				Id:            proto.FromUUID(s.Id),
+				AccountId:     proto.FromUUID(s.AccountId),
+				Role:          s.Role,
+				UnSubscribeAt: proto.ToTimestamp(s.UnSubscribeAt),
+				Observer:      s.Observer,
+			})
+		}
+
+		for i := range item.Pins {
+			p := &item.Pins[i]
+			room.Pins = append(room.Pins, &proto.PinnedMessage{
+				MessageId:       proto.FromUUID(p.MessageId),
+				Type:            p.Type,
+				Text:            p.Text,
+				SenderAccountId: proto.FromUUID(p.SenderAccountId),
+				CreatedAt:       proto.ToTimestamp(&p.CreatedAt),
+				PinnedBy:        proto.FromUUID(p.PinnedBy),
+				PinnedAt:        proto.ToTimestamp(&p.PinnedAt),
+			})
+		}
+
+		result.Rooms = append(result.Rooms, room)
+	}
+
+	return result, nil
+
+}
+
+func (r *RoomConverter) GetAccountRoomsRequestFromProto(request *proto.GetAccountRoomsRequest) (*GetAccountRoomsRequest, *system.Error) {
+
+	result := &GetAccountRoomsRequest{
+		WithClosed: request.WithClosed,
+		Limit:      int(request.Limit),
+		Cursor:     request.Cursor,
+	}
+
+	if request.AccountId != nil {
+		result.AccountId = AccountIdRequest{
+			AccountId:  request.AccountId.AccountId.ToUUID(),
+			ExternalId: request.AccountId.ExternalId,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) GetAccountRoomsResponseProtoFromModel(response *GetAccountRoomsResponse) (*proto.GetAccountRoomsResponse, *system.Error) {
+
+	result := &proto.GetAccountRoomsResponse{
+		Rooms:      []*proto.AccountRoom{},
+		NextCursor: response.NextCursor,
+		Errors:     ProtoErrorFromErrorRs(response.Errors),
+	}
+
+	for _, item := range response.Rooms {
+		room := &proto.AccountRoom{
+			Id:             proto.FromUUID(item.Id),
+			ReferenceId:    item.ReferenceId,
+			Type:           item.Type,
+			Title:          item.Title,
+			AvatarUrl:      item.AvatarUrl,
+			ClosedAt:       proto.ToTimestamp(item.ClosedAt),
+			LastActivityAt: proto.ToTimestamp(&item.LastActivityAt),
+			UnreadCount:    item.UnreadCount,
+			Subscribers:    []*proto.GetSubscriberResponse{},
+		}
+
+		if item.LastMessage != nil {
+			room.LastMessage = &proto.AccountRoomLastMessage{
+				Id:              proto.FromUUID(item.LastMessage.Id),
+				Type:            item.LastMessage.Type,
+				Text:            item.LastMessage.Text,
+				SenderAccountId: proto.FromUUID(item.LastMessage.SenderAccountId),
+				CreatedAt:       proto.ToTimestamp(&item.LastMessage.CreatedAt),
+				Expired:         item.LastMessage.Expired,
+				Deleted:         item.LastMessage.Deleted,
+			}
 		}
 
 		for _, s := range item.Subscribers {
@@ -129,6 +239,7 @@ func (r *RoomConverter) GetByCriteriaResponseProtoFromModel(request *GetRoomsByC
 				AccountId:     proto.FromUUID(s.AccountId),
 				Role:          s.Role,
 				UnSubscribeAt: proto.ToTimestamp(s.UnSubscribeAt),
+				Observer:      s.Observer,
 			})
 		}
 
@@ -136,14 +247,75 @@ func (r *RoomConverter) GetByCriteriaResponseProtoFromModel(request *GetRoomsByC
 	}
 
 	return result, nil
+}
+
+func (r *RoomConverter) TransferRequestFromProto(request *proto.TransferRoomRequest) (*TransferRoomRequest, *system.Error) {
+
+	result := &TransferRoomRequest{
+		RoomId:             request.RoomId.ToUUID(),
+		Reason:             request.Reason,
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
+	}
+
+	if request.FromAccount != nil {
+		result.FromAccount = AccountIdRequest{
+			AccountId:  request.FromAccount.AccountId.ToUUID(),
+			ExternalId: request.FromAccount.ExternalId,
+		}
+	}
+
+	if request.ToAccount != nil {
+		result.ToAccount = AccountIdRequest{
+			AccountId:  request.ToAccount.AccountId.ToUUID(),
+			ExternalId: request.ToAccount.ExternalId,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) TransferResponseProtoFromModel(request *TransferRoomResponse) (*proto.TransferRoomResponse, *system.Error) {
+
+	result := &proto.TransferRoomResponse{
+		Errors: ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) PromoteObserverRequestFromProto(request *proto.PromoteObserverRequest) (*PromoteObserverRequest, *system.Error) {
+
+	result := &PromoteObserverRequest{
+		RoomId:             request.RoomId.ToUUID(),
+		Role:               request.Role,
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
+	}
+
+	if request.Account != nil {
+		result.Account = AccountIdRequest{
+			AccountId:  request.Account.AccountId.ToUUID(),
+			ExternalId: request.Account.ExternalId,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) PromoteObserverResponseProtoFromModel(request *PromoteObserverResponse) (*proto.PromoteObserverResponse, *system.Error) {
 
+	result := &proto.PromoteObserverResponse{
+		Errors: ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	return result, nil
 }
 
 func (r *RoomConverter) CloseRoomRequestFromProto(request *proto.CloseRoomRequest) (*CloseRoomRequest, *system.Error) {
 
 	result := &CloseRoomRequest{
-		RoomId:      request.RoomId.ToUUID(),
-		ReferenceId: request.ReferenceId,
+		RoomId:             request.RoomId.ToUUID(),
+		ReferenceId:        request.ReferenceId,
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
 	}
 
 	return result, nil
@@ -159,6 +331,145 @@ func (r *RoomConverter) CloseRoomResponseProtoFromModel(request *CloseRoomRespon
 
 }
 
+func (r *RoomConverter) UpdateRoomRequestFromProto(request *proto.UpdateRoomRequest) (*UpdateRoomRequest, *system.Error) {
+
+	result := &UpdateRoomRequest{
+		RoomId:             request.RoomId.ToUUID(),
+		ReferenceId:        request.ReferenceId,
+		Title:              request.Title.ToString(),
+		Description:        request.Description.ToString(),
+		AvatarUrl:          request.AvatarUrl.ToString(),
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
+	}
+
+	if request.Attributes != nil {
+		result.Attributes = json.RawMessage("null")
+		if request.Attributes.Value != "" {
+			result.Attributes = json.RawMessage(request.Attributes.Value)
+		}
+	}
+
+	if request.Tags != nil {
+		tags := request.Tags.Values
+		result.Tags = &tags
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) UpdateRoomResponseProtoFromModel(request *UpdateRoomResponse) (*proto.UpdateRoomResponse, *system.Error) {
+
+	result := &proto.UpdateRoomResponse{
+		Errors: ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) ForwardMessagesRequestFromProto(request *proto.ForwardMessagesRequest) (*ForwardMessagesRequest, *system.Error) {
+
+	result := &ForwardMessagesRequest{
+		AccountId:    request.AccountId.ToUUID(),
+		SourceRoomId: request.SourceRoomId.ToUUID(),
+		TargetRoomId: request.TargetRoomId.ToUUID(),
+		MessageIds:   []uuid.UUID{},
+	}
+
+	for _, id := range request.MessageIds {
+		result.MessageIds = append(result.MessageIds, id.ToUUID())
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) ForwardMessagesResponseProtoFromModel(request *ForwardMessagesResponse) (*proto.ForwardMessagesResponse, *system.Error) {
+
+	result := &proto.ForwardMessagesResponse{
+		Errors: ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) PinMessageRequestFromProto(request *proto.PinMessageRequest) (*PinMessageRequest, *system.Error) {
+
+	result := &PinMessageRequest{
+		RoomId:             request.RoomId.ToUUID(),
+		MessageId:          request.MessageId.ToUUID(),
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) PinMessageResponseProtoFromModel(request *PinMessageResponse) (*proto.PinMessageResponse, *system.Error) {
+
+	result := &proto.PinMessageResponse{
+		Errors: ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) StarMessageRequestFromProto(request *proto.StarMessageRequest) (*StarMessageRequest, *system.Error) {
+
+	result := &StarMessageRequest{
+		AccountId: request.AccountId.ToUUID(),
+		MessageId: request.MessageId.ToUUID(),
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) StarMessageResponseProtoFromModel(request *StarMessageResponse) (*proto.StarMessageResponse, *system.Error) {
+
+	result := &proto.StarMessageResponse{
+		Errors: ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) ReopenRoomRequestFromProto(request *proto.ReopenRoomRequest) (*ReopenRoomRequest, *system.Error) {
+
+	result := &ReopenRoomRequest{
+		RoomId:             request.RoomId.ToUUID(),
+		ReferenceId:        request.ReferenceId,
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) ReopenRoomResponseProtoFromModel(request *ReopenRoomResponse) (*proto.ReopenRoomResponse, *system.Error) {
+
+	result := &proto.ReopenRoomResponse{
+		Errors: ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) ArchiveRoomRequestFromProto(request *proto.ArchiveRoomRequest) (*ArchiveRoomRequest, *system.Error) {
+
+	result := &ArchiveRoomRequest{
+		RoomId:             request.RoomId.ToUUID(),
+		ReferenceId:        request.ReferenceId,
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) ArchiveRoomResponseProtoFromModel(request *ArchiveRoomResponse) (*proto.ArchiveRoomResponse, *system.Error) {
+
+	result := &proto.ArchiveRoomResponse{
+		Errors: ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	return result, nil
+}
+
 func (r *RoomConverter) SendChatMessageRequestFromProto(request *proto.SendChatMessagesRequest) (*SendChatMessagesRequest, *system.Error) {
 
 	result := &SendChatMessagesRequest{
@@ -176,7 +487,12 @@ func (r *RoomConverter) SendChatMessageRequestFromProto(request *proto.SendChatM
 			Type:               m.Type,
 			Text:               m.Text,
 			Params:             m.Params,
+			Payload:            payloadToRaw(&m.Payload),
 			RecipientAccountId: m.RecipientAccountId.ToUUID(),
+			Visibility:         m.Visibility,
+			VisibleRoles:       m.VisibleRoles,
+			SendAt:             m.SendAt.ToTime(),
+			Ttl:                m.Ttl,
 		})
 	}
 
@@ -186,11 +502,258 @@ func (r *RoomConverter) SendChatMessageRequestFromProto(request *proto.SendChatM
 func (r *RoomConverter) SendChatMessageResponseProtoFromModel(request *SendChatMessageResponse) (*proto.SendChatMessageResponse, *system.Error) {
 
 	result := &proto.SendChatMessageResponse{
+		Errors:              ProtoErrorFromErrorRs(request.Errors),
+		ScheduledMessageIds: []*proto.UUID{},
+		HeldMessageIds:      []*proto.UUID{},
+	}
+
+	for _, id := range request.ScheduledMessageIds {
+		result.ScheduledMessageIds = append(result.ScheduledMessageIds, proto.FromUUID(id))
+	}
+
+	for _, id := range request.HeldMessageIds {
+		result.HeldMessageIds = append(result.HeldMessageIds, proto.FromUUID(id))
+	}
+
+	return result, nil
+
+}
+
+func (r *RoomConverter) GetScheduledMessagesRequestFromProto(request *proto.GetScheduledMessagesRequest) (*GetScheduledMessagesRequest, *system.Error) {
+
+	result := &GetScheduledMessagesRequest{
+		RoomId:    request.RoomId.ToUUID(),
+		AccountId: request.AccountId.ToUUID(),
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) GetScheduledMessagesResponseProtoFromModel(request *GetScheduledMessagesResponse) (*proto.GetScheduledMessagesResponse, *system.Error) {
+
+	result := &proto.GetScheduledMessagesResponse{
+		Messages: []*proto.ScheduledMessage{},
+		Errors:   ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	for i := range request.Messages {
+		item := &request.Messages[i]
+		result.Messages = append(result.Messages, &proto.ScheduledMessage{
+			Id:        proto.FromUUID(item.Id),
+			RoomId:    proto.FromUUID(item.RoomId),
+			AccountId: proto.FromUUID(item.AccountId),
+			SendAt:    proto.ToTimestamp(&item.SendAt),
+			Message:   sendChatMessageDataProtoFromModel(&item.Message),
+		})
+	}
+
+	return result, nil
+}
+
+func sendChatMessageDataProtoFromModel(message *SendChatMessageDataRequest) *proto.SendChatMessageDataRequest {
+	return &proto.SendChatMessageDataRequest{
+		ClientMessageId:    message.ClientMessageId,
+		RoomId:             proto.FromUUID(message.RoomId),
+		Type:               message.Type,
+		Text:               message.Text,
+		Params:             message.Params,
+		RecipientAccountId: proto.FromUUID(message.RecipientAccountId),
+		Payload:            string(message.Payload),
+		Visibility:         message.Visibility,
+		VisibleRoles:       message.VisibleRoles,
+	}
+}
+
+func (r *RoomConverter) CancelScheduledMessageRequestFromProto(request *proto.CancelScheduledMessageRequest) (*CancelScheduledMessageRequest, *system.Error) {
+
+	result := &CancelScheduledMessageRequest{
+		Id:                 request.Id.ToUUID(),
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) CancelScheduledMessageResponseProtoFromModel(request *CancelScheduledMessageResponse) (*proto.CancelScheduledMessageResponse, *system.Error) {
+
+	result := &proto.CancelScheduledMessageResponse{
+		Errors: ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) GetHeldMessagesRequestFromProto(request *proto.GetHeldMessagesRequest) (*GetHeldMessagesRequest, *system.Error) {
+
+	result := &GetHeldMessagesRequest{
+		RoomId:             request.RoomId.ToUUID(),
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) GetHeldMessagesResponseProtoFromModel(request *GetHeldMessagesResponse) (*proto.GetHeldMessagesResponse, *system.Error) {
+
+	result := &proto.GetHeldMessagesResponse{
+		Messages: []*proto.HeldMessage{},
+		Errors:   ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	for i := range request.Messages {
+		item := &request.Messages[i]
+		result.Messages = append(result.Messages, &proto.HeldMessage{
+			Id:        proto.FromUUID(item.Id),
+			RoomId:    proto.FromUUID(item.RoomId),
+			AccountId: proto.FromUUID(item.AccountId),
+			Filter:    item.Filter,
+			Reason:    item.Reason,
+			CreatedAt: proto.ToTimestamp(&item.CreatedAt),
+			Message:   sendChatMessageDataProtoFromModel(&item.Message),
+		})
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) ReviewHeldMessageRequestFromProto(request *proto.ReviewHeldMessageRequest) (*ReviewHeldMessageRequest, *system.Error) {
+
+	result := &ReviewHeldMessageRequest{
+		Id:                 request.Id.ToUUID(),
+		Approve:            request.Approve,
+		Reason:             request.Reason,
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) ReviewHeldMessageResponseProtoFromModel(request *ReviewHeldMessageResponse) (*proto.ReviewHeldMessageResponse, *system.Error) {
+
+	result := &proto.ReviewHeldMessageResponse{
 		Errors: ProtoErrorFromErrorRs(request.Errors),
 	}
 
 	return result, nil
+}
+
+func (r *RoomConverter) ReportMessageRequestFromProto(request *proto.ReportMessageRequest) (*ReportMessageRequest, *system.Error) {
+
+	result := &ReportMessageRequest{
+		AccountId: request.AccountId.ToUUID(),
+		MessageId: request.MessageId.ToUUID(),
+		Reason:    request.Reason,
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) ReportMessageResponseProtoFromModel(request *ReportMessageResponse) (*proto.ReportMessageResponse, *system.Error) {
+
+	result := &proto.ReportMessageResponse{
+		ReportId: proto.FromUUID(request.ReportId),
+		Errors:   ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) GetMessageReportsRequestFromProto(request *proto.GetMessageReportsRequest) (*GetMessageReportsRequest, *system.Error) {
+
+	result := &GetMessageReportsRequest{
+		RoomId:      request.RoomId.ToUUID(),
+		ContextSize: int(request.ContextSize),
+		PagingRequest: &PagingRequest{
+			Size:  int(request.PageSize),
+			Index: int(request.PageIndex),
+		},
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
+	}
+
+	return result, nil
+}
+
+func reportedMessageItemProtoFromModel(item *ReportedMessageItem) *proto.ReportedMessageItem {
+
+	recipientAccountId := uuid.Nil
+	if item.RecipientAccountId != nil {
+		recipientAccountId = *item.RecipientAccountId
+	}
+
+	return &proto.ReportedMessageItem{
+		Id:                 proto.FromUUID(item.Id),
+		Type:               item.Type,
+		Text:               item.Text,
+		FileId:             item.FileId,
+		SenderAccountId:    proto.FromUUID(item.SenderAccountId),
+		RecipientAccountId: proto.FromUUID(recipientAccountId),
+		Expired:            item.Expired,
+		Deleted:            item.Deleted,
+		CreatedAt:          proto.ToTimestamp(&item.CreatedAt),
+	}
+}
+
+func (r *RoomConverter) GetMessageReportsResponseProtoFromModel(request *GetMessageReportsResponse) (*proto.GetMessageReportsResponse, *system.Error) {
+
+	result := &proto.GetMessageReportsResponse{
+		Messages: []*proto.ReportedMessage{},
+		Errors:   ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	if request.Paging != nil {
+		result.Pages = int32(request.Paging.Total)
+		result.PageIndex = int32(request.Paging.Index)
+	}
+
+	for i := range request.Messages {
+		item := &request.Messages[i]
+
+		message := &proto.ReportedMessage{
+			RoomId:  proto.FromUUID(item.RoomId),
+			Message: reportedMessageItemProtoFromModel(&item.Message),
+			Reports: []*proto.MessageReport{},
+			Context: []*proto.ReportedMessageItem{},
+		}
+
+		for j := range item.Reports {
+			report := &item.Reports[j]
+			message.Reports = append(message.Reports, &proto.MessageReport{
+				Id:        proto.FromUUID(report.Id),
+				AccountId: proto.FromUUID(report.AccountId),
+				Reason:    report.Reason,
+				CreatedAt: proto.ToTimestamp(&report.CreatedAt),
+			})
+		}
+
+		for j := range item.Context {
+			message.Context = append(message.Context, reportedMessageItemProtoFromModel(&item.Context[j]))
+		}
+
+		result.Messages = append(result.Messages, message)
+	}
+
+	return result, nil
+}
+
+func (r *RoomConverter) ResolveMessageReportsRequestFromProto(request *proto.ResolveMessageReportsRequest) (*ResolveMessageReportsRequest, *system.Error) {
+
+	result := &ResolveMessageReportsRequest{
+		MessageId:          request.MessageId.ToUUID(),
+		Resolution:         request.Resolution,
+		Comment:            request.Comment,
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
+	}
 
+	return result, nil
+}
+
+func (r *RoomConverter) ResolveMessageReportsResponseProtoFromModel(request *ResolveMessageReportsResponse) (*proto.ResolveMessageReportsResponse, *system.Error) {
+
+	result := &proto.ResolveMessageReportsResponse{
+		Errors: ProtoErrorFromErrorRs(request.Errors),
+	}
+
+	return result, nil
 }
 
 func (r *RoomConverter) UnsubscribeRequestFromProto(request *proto.RoomUnsubscribeRequest) (*RoomUnsubscribeRequest, *system.Error) {
@@ -202,6 +765,7 @@ func (r *RoomConverter) UnsubscribeRequestFromProto(request *proto.RoomUnsubscri
 			AccountId:  request.AccountId.AccountId.ToUUID(),
 			ExternalId: request.AccountId.ExternalId,
 		},
+		InitiatorAccountId: request.InitiatorAccountId.ToUUID(),
 	}
 
 	return result, nil
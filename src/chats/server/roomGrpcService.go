@@ -86,6 +86,31 @@ func (s *RoomGrpcService) GetByCriteria(ctx context.Context, rq *proto.GetRoomsB
 	return protoRs, nil
 }
 
+func (s *RoomGrpcService) GetAccountRooms(ctx context.Context, rq *proto.GetAccountRoomsRequest) (*proto.GetAccountRoomsResponse, error) {
+
+	errorRs := &proto.GetAccountRoomsResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.GetAccountRoomsRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.GetAccountRooms(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.GetAccountRoomsResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
 func (s *RoomGrpcService) CloseRoom(ctx context.Context, rq *proto.CloseRoomRequest) (*proto.CloseRoomResponse, error) {
 
 	errorRs := &proto.CloseRoomResponse{}
@@ -160,3 +185,428 @@ func (s *RoomGrpcService) Unsubscribe(ctx context.Context, rq *proto.RoomUnsubsc
 
 	return protoRs, nil
 }
+
+func (s *RoomGrpcService) PromoteObserver(ctx context.Context, rq *proto.PromoteObserverRequest) (*proto.PromoteObserverResponse, error) {
+
+	errorRs := &proto.PromoteObserverResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.PromoteObserverRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.PromoteObserver(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.PromoteObserverResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoomGrpcService) Transfer(ctx context.Context, rq *proto.TransferRoomRequest) (*proto.TransferRoomResponse, error) {
+
+	errorRs := &proto.TransferRoomResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.TransferRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.TransferRoom(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.TransferResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoomGrpcService) UpdateRoom(ctx context.Context, rq *proto.UpdateRoomRequest) (*proto.UpdateRoomResponse, error) {
+
+	errorRs := &proto.UpdateRoomResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.UpdateRoomRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.UpdateRoom(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.UpdateRoomResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoomGrpcService) ReopenRoom(ctx context.Context, rq *proto.ReopenRoomRequest) (*proto.ReopenRoomResponse, error) {
+
+	errorRs := &proto.ReopenRoomResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.ReopenRoomRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.ReopenRoom(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.ReopenRoomResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoomGrpcService) ArchiveRoom(ctx context.Context, rq *proto.ArchiveRoomRequest) (*proto.ArchiveRoomResponse, error) {
+
+	errorRs := &proto.ArchiveRoomResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.ArchiveRoomRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.ArchiveRoom(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.ArchiveRoomResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoomGrpcService) GetScheduledMessages(ctx context.Context, rq *proto.GetScheduledMessagesRequest) (*proto.GetScheduledMessagesResponse, error) {
+
+	errorRs := &proto.GetScheduledMessagesResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.GetScheduledMessagesRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.GetScheduledMessages(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.GetScheduledMessagesResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoomGrpcService) CancelScheduledMessage(ctx context.Context, rq *proto.CancelScheduledMessageRequest) (*proto.CancelScheduledMessageResponse, error) {
+
+	errorRs := &proto.CancelScheduledMessageResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.CancelScheduledMessageRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.CancelScheduledMessage(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.CancelScheduledMessageResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoomGrpcService) GetHeldMessages(ctx context.Context, rq *proto.GetHeldMessagesRequest) (*proto.GetHeldMessagesResponse, error) {
+
+	errorRs := &proto.GetHeldMessagesResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.GetHeldMessagesRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.GetHeldMessages(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.GetHeldMessagesResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoomGrpcService) ReviewHeldMessage(ctx context.Context, rq *proto.ReviewHeldMessageRequest) (*proto.ReviewHeldMessageResponse, error) {
+
+	errorRs := &proto.ReviewHeldMessageResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.ReviewHeldMessageRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.ReviewHeldMessage(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.ReviewHeldMessageResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoomGrpcService) ReportMessage(ctx context.Context, rq *proto.ReportMessageRequest) (*proto.ReportMessageResponse, error) {
+
+	errorRs := &proto.ReportMessageResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.ReportMessageRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.ReportMessage(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.ReportMessageResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoomGrpcService) GetMessageReports(ctx context.Context, rq *proto.GetMessageReportsRequest) (*proto.GetMessageReportsResponse, error) {
+
+	errorRs := &proto.GetMessageReportsResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.GetMessageReportsRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.GetMessageReports(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.GetMessageReportsResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoomGrpcService) ResolveMessageReports(ctx context.Context, rq *proto.ResolveMessageReportsRequest) (*proto.ResolveMessageReportsResponse, error) {
+
+	errorRs := &proto.ResolveMessageReportsResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.ResolveMessageReportsRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.ResolveMessageReports(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.ResolveMessageReportsResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoomGrpcService) ForwardMessages(ctx context.Context, rq *proto.ForwardMessagesRequest) (*proto.ForwardMessagesResponse, error) {
+
+	errorRs := &proto.ForwardMessagesResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.ForwardMessagesRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.ForwardMessages(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.ForwardMessagesResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoomGrpcService) PinMessage(ctx context.Context, rq *proto.PinMessageRequest) (*proto.PinMessageResponse, error) {
+
+	errorRs := &proto.PinMessageResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.PinMessageRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.PinMessage(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.PinMessageResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoomGrpcService) UnpinMessage(ctx context.Context, rq *proto.PinMessageRequest) (*proto.PinMessageResponse, error) {
+
+	errorRs := &proto.PinMessageResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.PinMessageRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.UnpinMessage(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.PinMessageResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoomGrpcService) StarMessage(ctx context.Context, rq *proto.StarMessageRequest) (*proto.StarMessageResponse, error) {
+
+	errorRs := &proto.StarMessageResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.StarMessageRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.StarMessage(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.StarMessageResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
+
+func (s *RoomGrpcService) UnstarMessage(ctx context.Context, rq *proto.StarMessageRequest) (*proto.StarMessageResponse, error) {
+
+	errorRs := &proto.StarMessageResponse{}
+	c := &RoomConverter{}
+	modelRq, err := c.StarMessageRequestFromProto(rq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	modelRs, err := s.ws.UnstarMessage(modelRq)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	protoRs, err := c.StarMessageResponseProtoFromModel(modelRs)
+	if err != nil {
+		errorRs.Errors = []*proto.Error{ proto.Err(err) }
+		return errorRs, nil
+	}
+
+	return protoRs, nil
+}
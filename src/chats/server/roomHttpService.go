@@ -28,18 +28,94 @@ func (s *RoomHttpService) setRouting(router *mux.Router) {
 		s.GetRooms(writer, request)
 	}).Methods("GET")
 
+	router.HandleFunc("/api/v1/rooms/account", func(writer http.ResponseWriter, request *http.Request) {
+		s.GetAccountRooms(writer, request)
+	}).Methods("GET")
+
 	router.HandleFunc("/api/v1/rooms/close", func(writer http.ResponseWriter, request *http.Request) {
 		s.Close(writer, request)
 	}).Methods("POST")
 
+	router.HandleFunc("/api/v1/rooms/update", func(writer http.ResponseWriter, request *http.Request) {
+		s.Update(writer, request)
+	}).Methods("POST")
+
+	router.HandleFunc("/api/v1/rooms/reopen", func(writer http.ResponseWriter, request *http.Request) {
+		s.Reopen(writer, request)
+	}).Methods("POST")
+
+	router.HandleFunc("/api/v1/rooms/archive", func(writer http.ResponseWriter, request *http.Request) {
+		s.Archive(writer, request)
+	}).Methods("POST")
+
 	router.HandleFunc("/api/v1/rooms/messages/history", func(writer http.ResponseWriter, request *http.Request) {
 		s.GetMessageHistory(writer, request)
 	}).Methods("GET")
 
+	router.HandleFunc("/api/v1/rooms/messages/scheduled", func(writer http.ResponseWriter, request *http.Request) {
+		s.GetScheduledMessages(writer, request)
+	}).Methods("GET")
+
+	router.HandleFunc("/api/v1/rooms/messages/scheduled/cancel", func(writer http.ResponseWriter, request *http.Request) {
+		s.CancelScheduledMessage(writer, request)
+	}).Methods("POST")
+
+	router.HandleFunc("/api/v1/rooms/messages/held", func(writer http.ResponseWriter, request *http.Request) {
+		s.GetHeldMessages(writer, request)
+	}).Methods("GET")
+
+	router.HandleFunc("/api/v1/rooms/messages/held/review", func(writer http.ResponseWriter, request *http.Request) {
+		s.ReviewHeldMessage(writer, request)
+	}).Methods("POST")
+
+	router.HandleFunc("/api/v1/rooms/messages/report", func(writer http.ResponseWriter, request *http.Request) {
+		s.ReportMessage(writer, request)
+	}).Methods("POST")
+
+	router.HandleFunc("/api/v1/rooms/messages/reports", func(writer http.ResponseWriter, request *http.Request) {
+		s.GetMessageReports(writer, request)
+	}).Methods("GET")
+
+	router.HandleFunc("/api/v1/rooms/messages/reports/resolve", func(writer http.ResponseWriter, request *http.Request) {
+		s.ResolveMessageReports(writer, request)
+	}).Methods("POST")
+
+	router.HandleFunc("/api/v1/rooms/messages/forward", func(writer http.ResponseWriter, request *http.Request) {
+		s.ForwardMessages(writer, request)
+	}).Methods("POST")
+
+	router.HandleFunc("/api/v1/rooms/messages/pin", func(writer http.ResponseWriter, request *http.Request) {
+		s.PinMessage(writer, request)
+	}).Methods("POST")
+
+	router.HandleFunc("/api/v1/rooms/messages/unpin", func(writer http.ResponseWriter, request *http.Request) {
+		s.UnpinMessage(writer, request)
+	}).Methods("POST")
+
+	router.HandleFunc("/api/v1/rooms/messages/star", func(writer http.ResponseWriter, request *http.Request) {
+		s.StarMessage(writer, request)
+	}).Methods("POST")
+
+	router.HandleFunc("/api/v1/rooms/messages/unstar", func(writer http.ResponseWriter, request *http.Request) {
+		s.UnstarMessage(writer, request)
+	}).Methods("POST")
+
 	router.HandleFunc("/api/v1/rooms/unsubscribe", func(writer http.ResponseWriter, request *http.Request) {
 		s.Unsubscribe(writer, request)
 	}).Methods("POST")
 
+	router.HandleFunc("/api/v1/rooms/transfer", func(writer http.ResponseWriter, request *http.Request) {
+		s.Transfer(writer, request)
+	}).Methods("POST")
+
+	router.HandleFunc("/api/v1/rooms/observers/promote", func(writer http.ResponseWriter, request *http.Request) {
+		s.PromoteObserver(writer, request)
+	}).Methods("POST")
+
+	router.HandleFunc("/api/v1/rooms/join", func(writer http.ResponseWriter, request *http.Request) {
+		s.JoinByHash(writer, request)
+	}).Methods("POST")
+
 }
 
 func (s *RoomHttpService) Create(writer http.ResponseWriter, request *http.Request) {
@@ -102,6 +178,19 @@ func (s *RoomHttpService) GetRooms(writer http.ResponseWriter, request *http.Req
 		rq.WithClosed = closed
 	}
 
+	if tagsText := request.FormValue("tags"); tagsText != "" {
+		rq.Tags = strings.Split(tagsText, ",")
+	}
+
+	if archivedText := request.FormValue("archived"); archivedText != "" {
+		archived, e := strconv.ParseBool(archivedText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "archived error: " + e.Error())
+			return
+		}
+		rq.WithArchived = archived
+	}
+
 
 	if roomIdtext := request.FormValue("roomId"); roomIdtext != "" {
 		roomId, e := uuid.FromString(roomIdtext)
@@ -131,6 +220,52 @@ func (s *RoomHttpService) GetRooms(writer http.ResponseWriter, request *http.Req
 
 }
 
+func (s *RoomHttpService) GetAccountRooms(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &GetAccountRoomsRequest{
+		AccountId: AccountIdRequest{
+			ExternalId: request.FormValue("externalId"),
+		},
+		Cursor: request.FormValue("cursor"),
+	}
+
+	if accountIdtext := request.FormValue("accountId"); accountIdtext != "" {
+		accountId, e := uuid.FromString(accountIdtext)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "accountId error: "+e.Error())
+			return
+		}
+		rq.AccountId.AccountId = accountId
+	}
+
+	if closedText := request.FormValue("closed"); closedText != "" {
+		closed, e := strconv.ParseBool(closedText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "closed error: " + e.Error())
+			return
+		}
+		rq.WithClosed = closed
+	}
+
+	if limitText := request.FormValue("limit"); limitText != "" {
+		limit, e := strconv.Atoi(limitText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "limit error: " + e.Error())
+			return
+		}
+		rq.Limit = limit
+	}
+
+	rs, err := s.ws.GetAccountRooms(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusInternalServerError, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
 func (s *RoomHttpService) Close(writer http.ResponseWriter, request *http.Request) {
 
 	rq := &CloseRoomRequest{}
@@ -212,6 +347,15 @@ func (s *RoomHttpService) GetMessageHistory(writer http.ResponseWriter, request
 		rq.Criteria.SentOnly = sentOnly
 	}
 
+	if starredOnlyText := request.FormValue("starredOnly"); starredOnlyText != "" {
+		starredOnly, e := strconv.ParseBool(starredOnlyText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "starredOnly: " + e.Error())
+			return
+		}
+		rq.Criteria.StarredOnly = starredOnly
+	}
+
 	receivedOnlyText := request.FormValue("receivedOnly")
 	if receivedOnlyText != "" {
 		receivedOnly, e := strconv.ParseBool(receivedOnlyText)
@@ -315,4 +459,412 @@ func (s *RoomHttpService) Unsubscribe(writer http.ResponseWriter, request *http.
 
 	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
 
-}
\ No newline at end of file
+}
+
+func (s *RoomHttpService) Transfer(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &TransferRoomRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.TransferRoom(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) PromoteObserver(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &PromoteObserverRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.PromoteObserver(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) JoinByHash(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &JoinRoomByHashRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.JoinRoomByHash(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) Update(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &UpdateRoomRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.UpdateRoom(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) Reopen(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &ReopenRoomRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.ReopenRoom(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) Archive(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &ArchiveRoomRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.ArchiveRoom(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) GetScheduledMessages(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &GetScheduledMessagesRequest{}
+
+	if roomIdText := request.FormValue("roomId"); roomIdText != "" {
+		roomId, e := uuid.FromString(roomIdText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "roomId error: "+e.Error())
+			return
+		}
+		rq.RoomId = roomId
+	}
+
+	if accountIdText := request.FormValue("accountId"); accountIdText != "" {
+		accountId, e := uuid.FromString(accountIdText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "accountId error: "+e.Error())
+			return
+		}
+		rq.AccountId = accountId
+	}
+
+	rs, err := s.ws.GetScheduledMessages(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) CancelScheduledMessage(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &CancelScheduledMessageRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.CancelScheduledMessage(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) GetHeldMessages(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &GetHeldMessagesRequest{}
+
+	if roomIdText := request.FormValue("roomId"); roomIdText != "" {
+		roomId, e := uuid.FromString(roomIdText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "roomId error: "+e.Error())
+			return
+		}
+		rq.RoomId = roomId
+	}
+
+	if initiatorText := request.FormValue("initiatorAccountId"); initiatorText != "" {
+		initiatorId, e := uuid.FromString(initiatorText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "initiatorAccountId error: "+e.Error())
+			return
+		}
+		rq.InitiatorAccountId = initiatorId
+	}
+
+	rs, err := s.ws.GetHeldMessages(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) ReviewHeldMessage(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &ReviewHeldMessageRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.ReviewHeldMessage(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) ReportMessage(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &ReportMessageRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.ReportMessage(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) GetMessageReports(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &GetMessageReportsRequest{PagingRequest: &PagingRequest{}}
+
+	if roomIdText := request.FormValue("roomId"); roomIdText != "" {
+		roomId, e := uuid.FromString(roomIdText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "roomId error: "+e.Error())
+			return
+		}
+		rq.RoomId = roomId
+	}
+
+	if initiatorText := request.FormValue("initiatorAccountId"); initiatorText != "" {
+		initiatorId, e := uuid.FromString(initiatorText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "initiatorAccountId error: "+e.Error())
+			return
+		}
+		rq.InitiatorAccountId = initiatorId
+	}
+
+	if contextSizeText := request.FormValue("contextSize"); contextSizeText != "" {
+		contextSize, e := strconv.Atoi(contextSizeText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "contextSize error: "+e.Error())
+			return
+		}
+		rq.ContextSize = contextSize
+	}
+
+	if sizeText := request.FormValue("pageSize"); sizeText != "" {
+		size, e := strconv.Atoi(sizeText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "pageSize error: "+e.Error())
+			return
+		}
+		rq.PagingRequest.Size = size
+	}
+
+	if indexText := request.FormValue("pageIndex"); indexText != "" {
+		index, e := strconv.Atoi(indexText)
+		if e != nil {
+			s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "pageIndex error: "+e.Error())
+			return
+		}
+		rq.PagingRequest.Index = index
+	}
+
+	rs, err := s.ws.GetMessageReports(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) ResolveMessageReports(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &ResolveMessageReportsRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.ResolveMessageReports(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) ForwardMessages(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &ForwardMessagesRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.ForwardMessages(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) PinMessage(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &PinMessageRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.PinMessage(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) UnpinMessage(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &PinMessageRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.UnpinMessage(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) StarMessage(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &StarMessageRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.StarMessage(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
+
+func (s *RoomHttpService) UnstarMessage(writer http.ResponseWriter, request *http.Request) {
+
+	rq := &StarMessageRequest{}
+	decoder := json.NewDecoder(request.Body)
+	if err := decoder.Decode(rq); err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rs, err := s.ws.UnstarMessage(rq)
+	if err != nil {
+		s.ws.httpServer.respondWithError(writer, http.StatusBadRequest, err.Message)
+		return
+	}
+
+	s.ws.httpServer.respondWithJSON(writer, http.StatusOK, rs)
+
+}
@@ -0,0 +1,38 @@
+package server
+
+import (
+	"chats/system"
+	"encoding/json"
+	"strings"
+)
+
+// roomAttributes validates the room's attributes are a JSON object
+func roomAttributes(attributes json.RawMessage) (*string, *system.Error) {
+
+	if len(attributes) == 0 || string(attributes) == "null" {
+		return nil, nil
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal(attributes, &obj); err != nil {
+		return nil, system.SysErr(err, system.RoomAttributesInvalidCode, attributes)
+	}
+
+	return payloadFromRaw(attributes), nil
+}
+
+// roomTagsToString trims and deduplicates tags
+func roomTagsToString(tags []string) *string {
+
+	var result []string
+	found := make(map[string]bool)
+	for _, t := range tags {
+		t = strings.TrimSpace(t)
+		if t != "" && !found[t] {
+			found[t] = true
+			result = append(result, t)
+		}
+	}
+
+	return stringListToString(result)
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"encoding/json"
 	uuid "github.com/satori/go.uuid"
 	"time"
 )
@@ -9,6 +10,8 @@ type SubscriberRequest struct {
 	Account         *AccountIdRequest `json:"account"`
 	Role            string            `json:"role"`
 	AsSystemAccount bool              `json:"asSystemAccount"`
+	// observer silently watches the room (observer role is assigned if the role is empty)
+	AsObserver      bool              `json:"asObserver"`
 }
 
 type RoomRequest struct {
@@ -16,7 +19,29 @@ type RoomRequest struct {
 	Chat        bool                `json:"chat"`
 	Video       bool                `json:"video"`
 	Audio       bool                `json:"audio"`
+	// if populated, the room waits in the queue until an operator is assigned
+	Queue       string              `json:"queue"`
+	// direct | group | channel (group if empty)
+	Type        string              `json:"type"`
+	// lifetime of the room's hash in seconds (ROOM_HASH_TTL if empty)
+	HashTtl     int64               `json:"hashTtl"`
+	// the room's hash can be used to join only once
+	HashOneTime bool                `json:"hashOneTime"`
+	// the room is closed if there are no messages during the timeout in seconds (ROOM_INACTIVITY_TIMEOUT if empty)
+	InactivityTimeout int64         `json:"inactivityTimeout"`
+	// the room is closed at the time
+	CloseAt     *time.Time          `json:"closeAt"`
+	Title       string              `json:"title"`
+	Description string              `json:"description"`
+	AvatarUrl   string              `json:"avatarUrl"`
+	// arbitrary JSON object (e.g. order number, product)
+	Attributes  json.RawMessage     `json:"attributes"`
+	Tags        []string            `json:"tags"`
+	// messages of the room expire after the ttl in seconds unless the message's own ttl is specified
+	MessageTtl  int64               `json:"messageTtl"`
 	Subscribers []SubscriberRequest `json:"subscribers"`
+	// account creating the room (required for channels, must be among the subscribers with the invite capability)
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
 }
 
 type CreateRoomRequest struct {
@@ -38,6 +63,7 @@ type GetSubscriberResponse struct {
 	AccountId     uuid.UUID  `json:"accountId"`
 	Role          string     `json:"role"`
 	UnSubscribeAt *time.Time `json:"unsubscribeAt"`
+	Observer      bool       `json:"observer"`
 }
 
 type GetRoomResponse struct {
@@ -48,7 +74,48 @@ type GetRoomResponse struct {
 	Video       bool                    `json:"video"`
 	Audio       bool                    `json:"audio"`
 	ClosedAt    *time.Time              `json:"closedAt"`
+	ArchivedAt  *time.Time              `json:"archivedAt"`
+	Title       string                  `json:"title"`
+	Description string                  `json:"description"`
+	AvatarUrl   string                  `json:"avatarUrl"`
+	Attributes  json.RawMessage         `json:"attributes"`
+	Tags        []string                `json:"tags"`
+	MessageTtl  int64                   `json:"messageTtl"`
+	Queue       string                  `json:"queue"`
+	Type        string                  `json:"type"`
 	Subscribers []GetSubscriberResponse `json:"subscribers"`
+	Pins        []PinnedMessage         `json:"pins"`
+}
+
+type PinnedMessage struct {
+	MessageId       uuid.UUID `json:"messageId"`
+	Type            string    `json:"type"`
+	Text            string    `json:"text"`
+	SenderAccountId uuid.UUID `json:"senderAccountId"`
+	CreatedAt       time.Time `json:"createdAt"`
+	// account pinned the message
+	PinnedBy        uuid.UUID `json:"pinnedBy"`
+	PinnedAt        time.Time `json:"pinnedAt"`
+}
+
+type PinMessageRequest struct {
+	RoomId    uuid.UUID `json:"roomId"`
+	MessageId uuid.UUID `json:"messageId"`
+	// account pinning the message (must have the pin capability)
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
+}
+
+type PinMessageResponse struct {
+	Errors []ErrorResponse `json:"errors"`
+}
+
+type StarMessageRequest struct {
+	AccountId uuid.UUID `json:"accountId"`
+	MessageId uuid.UUID `json:"messageId"`
+}
+
+type StarMessageResponse struct {
+	Errors []ErrorResponse `json:"errors"`
 }
 
 type GetRoomsByCriteriaRequest struct {
@@ -56,7 +123,11 @@ type GetRoomsByCriteriaRequest struct {
 	AccountId       *AccountIdRequest `json:"accountId"`
 	RoomId          uuid.UUID         `json:"roomId"`
 	WithClosed      bool              `json:"withClosed"`
+	// archived rooms are hidden by default
+	WithArchived    bool              `json:"withArchived"`
 	WithSubscribers bool              `json:"withSubscribers"`
+	// rooms having all the tags
+	Tags            []string          `json:"tags"`
 }
 
 type GetRoomsByCriteriaResponse struct {
@@ -64,10 +135,58 @@ type GetRoomsByCriteriaResponse struct {
 	Errors []ErrorResponse   `json:"errors"`
 }
 
+type GetAccountRoomsRequest struct {
+	AccountId  AccountIdRequest `json:"accountId"`
+	WithClosed bool             `json:"withClosed"`
+	// page size (20 by default)
+	Limit      int              `json:"limit"`
+	// cursor returned with the previous page (empty for the first page)
+	Cursor     string           `json:"cursor"`
+}
+
+type AccountRoomLastMessage struct {
+	Id              uuid.UUID `json:"id"`
+	Type            string    `json:"type"`
+	Text            string    `json:"text"`
+	SenderAccountId uuid.UUID `json:"senderAccountId"`
+	CreatedAt       time.Time `json:"createdAt"`
+	// the message's text is wiped out on expiration
+	Expired bool `json:"expired"`
+	// the message's text is wiped out by a moderator
+	Deleted bool `json:"deleted"`
+}
+
+type AccountRoom struct {
+	Id             uuid.UUID               `json:"id"`
+	ReferenceId    string                  `json:"referenceId"`
+	Type           string                  `json:"type"`
+	Title          string                  `json:"title"`
+	AvatarUrl      string                  `json:"avatarUrl"`
+	ClosedAt       *time.Time              `json:"closedAt"`
+	LastActivityAt time.Time               `json:"lastActivityAt"`
+	LastMessage    *AccountRoomLastMessage `json:"lastMessage"`
+	UnreadCount    int64                   `json:"unreadCount"`
+	Subscribers    []GetSubscriberResponse `json:"subscribers"`
+}
+
+type GetAccountRoomsResponse struct {
+	Rooms      []AccountRoom   `json:"rooms"`
+	// empty if there are no more rooms
+	NextCursor string          `json:"nextCursor"`
+	Errors     []ErrorResponse `json:"errors"`
+}
+
 type RoomSubscribeRequest struct {
 	RoomId      uuid.UUID           `json:"roomId"`
 	ReferenceId string              `json:"referenceId"`
 	Subscribers []SubscriberRequest `json:"subscribers"`
+	// account adding subscribers (must have the invite capability, required for channels)
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
+	// the chat service subscribes the accounts itself (e.g. queue assignment, join by link), the initiator isn't checked
+	// not exposed to clients
+	Internal bool `json:"-"`
+	// the subscribers' other open rooms aren't closed (set by the queue dispatcher, not exposed to clients)
+	KeepOpenRooms bool `json:"-"`
 }
 
 type RoomSubscribeResponse struct {
@@ -78,6 +197,8 @@ type RoomUnsubscribeRequest struct {
 	RoomId      uuid.UUID        `json:"roomId"`
 	ReferenceId string           `json:"referenceId"`
 	AccountId   AccountIdRequest `json:"accountId"`
+	// account removing the subscriber (must have the kick capability unless leaving the room)
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
 }
 
 type RoomUnsubscribeResponse struct {
@@ -95,15 +216,105 @@ type RoomMessageAccountUnsubscribeRequest struct {
 	RoomId    uuid.UUID `json:"roomId"`
 }
 
+type RoomClosedMessage struct {
+	RoomIds []uuid.UUID `json:"roomIds"`
+	Reason  string      `json:"reason"`
+}
+
 type CloseRoomRequest struct {
 	RoomId      uuid.UUID `json:"roomId"`
 	ReferenceId string    `json:"referenceId"`
+	// account closing the room (must have the close capability)
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
 }
 
 type CloseRoomResponse struct {
 	Errors []ErrorResponse `json:"errors"`
 }
 
+// omitted (nil) fields aren't changed
+type UpdateRoomRequest struct {
+	RoomId      uuid.UUID `json:"roomId"`
+	ReferenceId string    `json:"referenceId"`
+	Title       *string   `json:"title"`
+	Description *string   `json:"description"`
+	AvatarUrl   *string   `json:"avatarUrl"`
+	// null clears the attributes
+	Attributes json.RawMessage `json:"attributes"`
+	Tags       *[]string       `json:"tags"`
+	// account updating the room (must have the edit-room capability)
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
+}
+
+type UpdateRoomResponse struct {
+	Errors []ErrorResponse `json:"errors"`
+}
+
+type ReopenRoomRequest struct {
+	RoomId      uuid.UUID `json:"roomId"`
+	ReferenceId string    `json:"referenceId"`
+	// account reopening the room (must have the close capability)
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
+}
+
+type ReopenRoomResponse struct {
+	Errors []ErrorResponse `json:"errors"`
+}
+
+type ArchiveRoomRequest struct {
+	RoomId      uuid.UUID `json:"roomId"`
+	ReferenceId string    `json:"referenceId"`
+	// account archiving the room (must have the close capability)
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
+}
+
+type ArchiveRoomResponse struct {
+	Errors []ErrorResponse `json:"errors"`
+}
+
+type TransferRoomRequest struct {
+	RoomId      uuid.UUID        `json:"roomId"`
+	FromAccount AccountIdRequest `json:"fromAccount"`
+	ToAccount   AccountIdRequest `json:"toAccount"`
+	// optional comment added to the system message about the transfer
+	Reason string `json:"reason"`
+	// account transferring the room, recorded in the audit log
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
+}
+
+type TransferRoomResponse struct {
+	Errors []ErrorResponse `json:"errors"`
+}
+
+type JoinRoomByHashRequest struct {
+	Hash      string `json:"hash"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Email     string `json:"email"`
+	Phone     string `json:"phone"`
+}
+
+type JoinRoomByHashResponse struct {
+	RoomId    uuid.UUID `json:"roomId"`
+	AccountId uuid.UUID `json:"accountId"`
+	// token to connect /ws/
+	Token  string          `json:"token"`
+	Errors []ErrorResponse `json:"errors"`
+}
+
+type PromoteObserverRequest struct {
+	RoomId  uuid.UUID        `json:"roomId"`
+	Account AccountIdRequest `json:"account"`
+	// role of the promoted subscriber (member if empty)
+	Role string `json:"role"`
+	// account promoting the observer (must have the invite capability)
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
+}
+
+type PromoteObserverResponse struct {
+	Errors []ErrorResponse `json:"errors"`
+}
+
 type SortRequest struct {
 	Field string `json:"field"`
 	// ask | desc
@@ -143,6 +354,8 @@ type GetMessageHistoryCriteria struct {
 	// if true retrieves only messages received by the given account (works together with AccountId)
 	// mutual exclusion with SentOnly (SentOnly == true && ReceivedOnly == true -> invalid criteria combination)
 	ReceivedOnly bool `json:"receivedOnly"`
+	// if true retrieves only messages starred by the given account (works together with AccountId)
+	StarredOnly bool `json:"starredOnly"`
 }
 
 type GetMessageHistoryRequest struct {
@@ -182,14 +395,29 @@ type MessageHistoryItem struct {
 	ClientMessageId string            `json:"clientMessageId"`
 	ReferenceId     string            `json:"referenceId"`
 	RoomId          uuid.UUID         `json:"roomId"`
+	RoomType        string            `json:"roomType"`
 	Type            string            `json:"type"`
 	Message         string            `json:"message"`
 	FileId          string            `json:"fileId"`
 	Params          map[string]string `json:"params"`
+	// structured content of rich message types (buttons, card etc.)
+	Payload json.RawMessage `json:"payload,omitempty"`
 	// Account Id of the account who has sent this message
 	SenderAccountId uuid.UUID `json:"senderAccountId"`
 	// Populated if it's a private message for the particular account subscriber
 	RecipientAccountId *uuid.UUID `json:"recipientAccountId"`
+	// all | recipient | roles
+	Visibility string `json:"visibility"`
+	// roles the message is visible to (roles visibility)
+	VisibleRoles []string `json:"visibleRoles,omitempty"`
+	// the original message if the message is forwarded
+	ForwardedFrom *ForwardedFrom `json:"forwardedFrom,omitempty"`
+	// the message content is wiped out at the time
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// the message content has been wiped out
+	Expired bool `json:"expired"`
+	// the message content has been wiped out by a moderator
+	Deleted bool `json:"deleted"`
 	// Message statuses for all room's accounts map[accountId]status
 	Statuses []MessageStatus `json:"statuses"`
 }
@@ -198,6 +426,10 @@ type SendChatMessagesRequest struct {
 	SenderAccountId uuid.UUID                   `json:"senderAccountId"`
 	Type            string                      `json:"type"`
 	Data            SendChatMessagesDataRequest `json:"data"`
+	// messages have been already approved by a moderator, so the moderation chain is skipped
+	Moderated       bool                        `json:"-"`
+	// messages are sent via WebSocket, so they're charged to the room's rate limit
+	RateLimited     bool                        `json:"-"`
 }
 
 type SendChatMessagesDataRequest struct {
@@ -210,9 +442,182 @@ type SendChatMessageDataRequest struct {
 	Type               string            `json:"type"`
 	Text               string            `json:"text"`
 	Params             map[string]string `json:"params"`
+	Payload            json.RawMessage   `json:"payload"`
 	RecipientAccountId uuid.UUID         `json:"recipientAccountId"`
+	// all | recipient | roles (all or recipient if empty depending on RecipientAccountId)
+	Visibility         string            `json:"visibility"`
+	// roles the message is visible to, required for roles visibility
+	VisibleRoles       []string          `json:"visibleRoles"`
+	// the message is scheduled to be sent at the time (if populated and in the future)
+	SendAt             *time.Time        `json:"sendAt"`
+	// the message expires after the ttl in seconds (the room's message ttl if empty)
+	Ttl                int64             `json:"ttl"`
+	// populated by forwarding only
+	FileId             string            `json:"-"`
+	ForwardedFrom      *ForwardedFrom    `json:"-"`
+}
+
+type ForwardedFrom struct {
+	MessageId uuid.UUID `json:"messageId"`
+	RoomId    uuid.UUID `json:"roomId"`
+	// sender of the original message
+	AccountId uuid.UUID `json:"accountId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type ForwardMessagesRequest struct {
+	// account forwarding messages (must be subscribed to the both rooms)
+	AccountId    uuid.UUID   `json:"accountId"`
+	SourceRoomId uuid.UUID   `json:"sourceRoomId"`
+	TargetRoomId uuid.UUID   `json:"targetRoomId"`
+	MessageIds   []uuid.UUID `json:"messageIds"`
+}
+
+type ForwardMessagesResponse struct {
+	Errors []ErrorResponse `json:"errors"`
 }
 
 type SendChatMessageResponse struct {
+	// ids of messages scheduled to be sent later
+	ScheduledMessageIds []uuid.UUID     `json:"scheduledMessageIds"`
+	// ids of messages held by the moderation for review
+	HeldMessageIds      []uuid.UUID     `json:"heldMessageIds"`
+	Errors              []ErrorResponse `json:"errors"`
+}
+
+type ScheduledMessage struct {
+	Id        uuid.UUID                  `json:"id"`
+	RoomId    uuid.UUID                  `json:"roomId"`
+	AccountId uuid.UUID                  `json:"accountId"`
+	SendAt    time.Time                  `json:"sendAt"`
+	Message   SendChatMessageDataRequest `json:"message"`
+}
+
+type GetScheduledMessagesRequest struct {
+	RoomId    uuid.UUID `json:"roomId"`
+	// messages of the sender only (if populated)
+	AccountId uuid.UUID `json:"accountId"`
+}
+
+type GetScheduledMessagesResponse struct {
+	Messages []ScheduledMessage `json:"messages"`
+	Errors   []ErrorResponse    `json:"errors"`
+}
+
+type CancelScheduledMessageRequest struct {
+	Id uuid.UUID `json:"id"`
+	// account cancelling the message (must be the sender or have the delete-any capability)
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
+}
+
+type CancelScheduledMessageResponse struct {
+	Errors []ErrorResponse `json:"errors"`
+}
+
+type HeldMessage struct {
+	Id        uuid.UUID                  `json:"id"`
+	RoomId    uuid.UUID                  `json:"roomId"`
+	AccountId uuid.UUID                  `json:"accountId"`
+	// moderation filter held the message
+	Filter    string                     `json:"filter"`
+	Reason    string                     `json:"reason"`
+	CreatedAt time.Time                  `json:"createdAt"`
+	Message   SendChatMessageDataRequest `json:"message"`
+}
+
+type GetHeldMessagesRequest struct {
+	RoomId uuid.UUID `json:"roomId"`
+	// account reviewing messages (must have the delete-any capability)
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
+}
+
+type GetHeldMessagesResponse struct {
+	Messages []HeldMessage   `json:"messages"`
+	Errors   []ErrorResponse `json:"errors"`
+}
+
+type ReviewHeldMessageRequest struct {
+	Id uuid.UUID `json:"id"`
+	// the message is sent if approved, otherwise the sender gets the rejection error
+	Approve bool   `json:"approve"`
+	Reason  string `json:"reason"`
+	// account reviewing the message (must have the delete-any capability)
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
+}
+
+type ReviewHeldMessageResponse struct {
+	Errors []ErrorResponse `json:"errors"`
+}
+
+type ReportMessageRequest struct {
+	// reporting account
+	AccountId uuid.UUID `json:"accountId"`
+	MessageId uuid.UUID `json:"messageId"`
+	Reason    string    `json:"reason"`
+}
+
+type ReportMessageResponse struct {
+	ReportId uuid.UUID       `json:"reportId"`
+	Errors   []ErrorResponse `json:"errors"`
+}
+
+type MessageReport struct {
+	Id        uuid.UUID `json:"id"`
+	// reporting account
+	AccountId uuid.UUID `json:"accountId"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type ReportedMessageItem struct {
+	Id                 uuid.UUID  `json:"id"`
+	Type               string     `json:"type"`
+	Text               string     `json:"text"`
+	FileId             string     `json:"fileId"`
+	SenderAccountId    uuid.UUID  `json:"senderAccountId"`
+	RecipientAccountId *uuid.UUID `json:"recipientAccountId"`
+	// the message content has been wiped out
+	Expired            bool       `json:"expired"`
+	// the message content has been wiped out by a moderator
+	Deleted            bool       `json:"deleted"`
+	CreatedAt          time.Time  `json:"createdAt"`
+}
+
+type ReportedMessage struct {
+	RoomId  uuid.UUID           `json:"roomId"`
+	Message ReportedMessageItem `json:"message"`
+	// open reports (the earliest first)
+	Reports []MessageReport     `json:"reports"`
+	// messages of the room sent right before and after the reported one
+	Context []ReportedMessageItem `json:"context"`
+}
+
+type GetMessageReportsRequest struct {
+	// reports of the room only (if populated)
+	RoomId uuid.UUID `json:"roomId"`
+	// number of messages sent before and after the reported one returned as the context
+	ContextSize   int            `json:"contextSize"`
+	PagingRequest *PagingRequest `json:"pagingRequest"`
+	// moderator (must have the delete-any capability in the room, the room must be specified then)
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
+}
+
+type GetMessageReportsResponse struct {
+	Messages []ReportedMessage `json:"messages"`
+	Paging   *PagingResponse   `json:"paging"`
+	Errors   []ErrorResponse   `json:"errors"`
+}
+
+type ResolveMessageReportsRequest struct {
+	MessageId uuid.UUID `json:"messageId"`
+	// dismiss | delete | lock
+	Resolution string `json:"resolution"`
+	// sent to the reporting accounts
+	Comment string `json:"comment"`
+	// moderator (must have the delete-any capability in the room)
+	InitiatorAccountId uuid.UUID `json:"initiatorAccountId"`
+}
+
+type ResolveMessageReportsResponse struct {
 	Errors []ErrorResponse `json:"errors"`
 }
@@ -4,14 +4,62 @@ import (
 	"chats/app"
 	"chats/repository"
 	a "chats/repository/account"
+	q "chats/repository/queue"
 	r "chats/repository/room"
 	"chats/system"
+	"crypto/rand"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	uuid "github.com/satori/go.uuid"
+	"strconv"
+	"strings"
 	"time"
 )
 
+const (
+	RoomTypeDirect  = "direct"
+	RoomTypeGroup   = "group"
+	RoomTypeChannel = "channel"
+)
+
+// checkChannelCreator checks the initiator creating the channel subscribes on it with the invite capability
+func checkChannelCreator(room *r.Room, initiatorAccountId uuid.UUID) *system.Error {
+
+	if initiatorAccountId == uuid.Nil {
+		return system.SysErrf(nil, system.InitiatorRequiredCode, nil, room.Type)
+	}
+
+	for _, s := range room.Subscribers {
+		if s.AccountId == initiatorAccountId && !system.Uint8ToBool(s.Observer) {
+			return checkSubscriberCapability(room.Id, initiatorAccountId, s.Role, CapabilityInvite)
+		}
+	}
+
+	return system.SysErrf(nil, system.NotSubscribedAccountCode, nil, initiatorAccountId.String(), room.Id.String())
+}
+
+// multipleOpenRoomType checks if rooms of the type can be open along with other rooms of their subscribers
+func multipleOpenRoomType(roomType string) bool {
+	for _, t := range app.Instance.Env.MultipleOpenRoomTypes() {
+		if t == roomType {
+			return true
+		}
+	}
+	return false
+}
+
+// generateToken generates an unguessable token (the room's hash to join by a link, the anonymous account's session token)
+func generateToken() (string, *system.Error) {
+
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", system.E(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
 func (ws *WsServer) sendRoomSubscribeMessage(roomId uuid.UUID, accountId uuid.UUID, role string) {
 
 	//	subscribe websocket hub
@@ -32,6 +80,24 @@ func (ws *WsServer) sendRoomSubscribeMessage(roomId uuid.UUID, accountId uuid.UU
 	ws.hub.SendMessageToRoom(roomMessage)
 }
 
+// sendRoomClosedMessage unloads the closed rooms on all the nodes
+func (ws *WsServer) sendRoomClosedMessage(roomIds []uuid.UUID, reason string) {
+
+	if len(roomIds) == 0 {
+		return
+	}
+
+	ws.hub.SendMessageToRoom(&RoomMessage{
+		Message: &WSChatResponse{
+			Type: system.SystemMsgTypeRoomClosed,
+			Data: &RoomClosedMessage{
+				RoomIds: roomIds,
+				Reason:  reason,
+			},
+		},
+	})
+}
+
 func (ws *WsServer) sendRoomUnsubscribeMessage(roomId uuid.UUID, accountId uuid.UUID) {
 
 	//	subscribe websocket hub
@@ -48,6 +114,80 @@ func (ws *WsServer) sendRoomUnsubscribeMessage(roomId uuid.UUID, accountId uuid.
 	ws.hub.SendMessageToRoom(roomMessage)
 }
 
+// sendRoomSystemMessage posts a message on behalf of the chat service to all the room's subscribers
+func (ws *WsServer) sendRoomSystemMessage(roomId uuid.UUID, text string, params map[string]string) *system.Error {
+
+	roomRep := r.CreateRepository(app.GetDB())
+
+	loc, e := app.Instance.GetLocation()
+	if e != nil {
+		return system.SysErr(e, system.LoadLocationErrorCode, nil)
+	}
+
+	subscribers, err := roomRep.GetRoomSubscribers(roomId)
+	if err != nil {
+		return err
+	}
+
+	var opponents []r.ChatOpponent
+	for _, s := range subscribers {
+		// the cached list keeps the former subscribers
+		if s.UnsubscribeAt != nil || system.Uint8ToBool(s.Observer) {
+			continue
+		}
+		opponents = append(opponents, r.ChatOpponent{
+			SubscriberId:  s.Id,
+			AccountId:     s.AccountId,
+			SystemAccount: system.Uint8ToBool(s.SystemAccount),
+		})
+	}
+
+	paramsJson, e := json.Marshal(params)
+	if e != nil {
+		return system.SysErr(e, system.UnmarshallingErrorCode, nil)
+	}
+
+	dbMessage := &r.ChatMessage{
+		Id:              system.Uuid(),
+		ClientMessageId: system.Uuid().String(),
+		RoomId:          roomId,
+		AccountId:       uuid.Nil,
+		SubscribeId:     uuid.Nil,
+		Type:            MessageTypeSystem,
+		Message:         text,
+		Params:          string(paramsJson),
+	}
+
+	err = roomRep.CreateMessage(dbMessage, opponents)
+	if err != nil {
+		return err
+	}
+
+	ws.hub.SendMessageToRoom(&RoomMessage{
+		RoomId: roomId,
+		Message: &WSChatResponse{
+			Type: EventMessage,
+			Data: WSChatMessagesDataResponse{
+				Messages: []interface{}{&WSChatMessagesDataMessageResponse{
+					Id:              dbMessage.Id,
+					ClientMessageId: dbMessage.ClientMessageId,
+					InsertDate:      dbMessage.CreatedAt.In(loc).Format(time.RFC3339),
+					ChatId:          roomId,
+					AccountId:       uuid.Nil,
+					Sender:          MessageTypeSystem,
+					Status:          r.MessageStatusRecd,
+					Type:            MessageTypeSystem,
+					Text:            text,
+					Params:          params,
+				}},
+				Accounts: []Account{},
+			},
+		},
+	})
+
+	return nil
+}
+
 func (ws *WsServer) CreateRoom(request *CreateRoomRequest) (*CreateRoomResponse, *system.Error) {
 
 	defer app.E().CatchPanic("CreateRoom")
@@ -55,17 +195,72 @@ func (ws *WsServer) CreateRoom(request *CreateRoomRequest) (*CreateRoomResponse,
 	accRep := a.CreateRepository(app.GetDB())
 	roomRep := r.CreateRepository(app.GetDB())
 
+	roomType := request.Room.Type
+	if roomType == "" {
+		roomType = RoomTypeGroup
+	}
+
+	if roomType != RoomTypeDirect && roomType != RoomTypeGroup && roomType != RoomTypeChannel {
+		return nil, system.SysErrf(nil, system.RoomTypeNotSupportedCode, nil, roomType)
+	}
+
+	if err := checkRolesExist(request.Room.Subscribers); err != nil {
+		return nil, err
+	}
+
+	hash, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+
 	roomModel := &r.Room{
 		Id:          system.Uuid(),
 		ReferenceId: request.Room.ReferenceId,
-		// TODO: generate hash
-		Hash:        "",
+		Hash:        hash,
+		HashOneTime: system.BoolToUint8(request.Room.HashOneTime),
 		Audio:       system.BoolToUint8(request.Room.Audio),
 		Video:       system.BoolToUint8(request.Room.Video),
 		Chat:        system.BoolToUint8(request.Room.Chat),
+		Queue:       request.Room.Queue,
+		Type:        roomType,
+		Title:       request.Room.Title,
+		Description: request.Room.Description,
+		AvatarUrl:   request.Room.AvatarUrl,
+		Tags:        roomTagsToString(request.Room.Tags),
 		Subscribers: []r.RoomSubscriber{},
 	}
 
+	roomModel.Attributes, err = roomAttributes(request.Room.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Room.CloseAt != nil {
+		if !request.Room.CloseAt.After(time.Now()) {
+			return nil, system.SysErr(nil, system.RoomCloseAtInvalidCode, nil)
+		}
+		roomModel.CloseAt = request.Room.CloseAt
+	}
+
+	inactivityTimeout := time.Duration(request.Room.InactivityTimeout) * time.Second
+	if inactivityTimeout <= 0 {
+		inactivityTimeout = app.Instance.Env.RoomInactivityTimeout()
+	}
+	roomModel.InactivityTimeout = int64(inactivityTimeout / time.Second)
+
+	if request.Room.MessageTtl > 0 {
+		roomModel.MessageTtl = request.Room.MessageTtl
+	}
+
+	hashTtl := time.Duration(request.Room.HashTtl) * time.Second
+	if hashTtl <= 0 {
+		hashTtl = app.Instance.Env.RoomHashTtl()
+	}
+	if hashTtl > 0 {
+		expiresAt := time.Now().Add(hashTtl)
+		roomModel.HashExpiresAt = &expiresAt
+	}
+
 	var accountIds []uuid.UUID
 	for _, s := range request.Room.Subscribers {
 
@@ -83,22 +278,69 @@ func (ws *WsServer) CreateRoom(request *CreateRoomRequest) (*CreateRoomResponse,
 			}
 		}
 
+		role := subscriberRole(s)
+
 		roomModel.Subscribers = append(roomModel.Subscribers, r.RoomSubscriber{
 			Id:            system.Uuid(),
 			RoomId:        roomModel.Id,
 			AccountId:     account.Id,
-			Role:          s.Role,
+			Role:          role,
 			SystemAccount: system.BoolToUint8(s.AsSystemAccount),
+			Observer:      system.BoolToUint8(s.AsObserver),
 		})
 
-		accountIds = append(accountIds, account.Id)
+		// observers don't affect other rooms of the account
+		if !s.AsObserver && !app.Instance.Env.MultipleOpenRooms(role) {
+			accountIds = append(accountIds, account.Id)
+		}
 
 	}
 
-	// close all opened rooms for accounts
-	err := ws.CloseRoomsByAccounts(accountIds)
-	if err != nil {
-		return nil, err
+	// channels are created by their admins
+	if roomType == RoomTypeChannel {
+		err := checkChannelCreator(roomModel, request.Room.InitiatorAccountId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if roomType == RoomTypeDirect {
+
+		var participants []uuid.UUID
+		for _, s := range roomModel.Subscribers {
+			if !system.Uint8ToBool(s.Observer) {
+				participants = append(participants, s.AccountId)
+			}
+		}
+
+		if len(participants) != 2 || participants[0] == participants[1] {
+			return nil, system.SysErr(nil, system.DirectRoomSubscribersCode, nil)
+		}
+
+		// there is only one open direct room for a pair of accounts
+		existent, err := roomRep.FindDirectRoom(participants[0], participants[1])
+		if err != nil {
+			return nil, err
+		}
+
+		if existent != nil {
+			response := &CreateRoomResponse{
+				Result: &RoomResponse{
+					Id:   existent.Id,
+					Hash: existent.Hash,
+				},
+				Errors: []ErrorResponse{},
+			}
+			return response, nil
+		}
+	}
+
+	// close all opened rooms for accounts which are allowed to have only one
+	if !multipleOpenRoomType(roomType) {
+		err := ws.CloseRoomsByAccounts(accountIds)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// create a new open room
@@ -111,6 +353,20 @@ func (ws *WsServer) CreateRoom(request *CreateRoomRequest) (*CreateRoomResponse,
 		go ws.sendRoomSubscribeMessage(roomId, s.AccountId, s.Role)
 	}
 
+	writeAudit(request.Room.InitiatorAccountId, AuditActionRoomCreate, AuditTargetRoom, roomId, nil, auditRoomState(roomModel))
+
+	// put the room to the queue, an operator will be assigned by the dispatcher
+	if roomModel.Queue != "" {
+		err := q.CreateRepository(app.GetDB()).CreateItem(&q.QueueItem{
+			Id:     system.Uuid(),
+			Queue:  roomModel.Queue,
+			RoomId: roomId,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	response := &CreateRoomResponse{
 		Result: &RoomResponse{
 			Id:   roomModel.Id,
@@ -124,22 +380,23 @@ func (ws *WsServer) CreateRoom(request *CreateRoomRequest) (*CreateRoomResponse,
 }
 
 func (ws *WsServer) CloseRoomsByAccounts(accountIds []uuid.UUID) *system.Error {
+	return ws.closeOtherRoomsByAccounts(accountIds, uuid.Nil)
+}
+
+// closeOtherRoomsByAccounts closes open rooms of the accounts except the given one
+func (ws *WsServer) closeOtherRoomsByAccounts(accountIds []uuid.UUID, exceptRoomId uuid.UUID) *system.Error {
 
-	defer app.E().CatchPanic("CloseRoomsByAccounts")
+	defer app.E().CatchPanic("closeOtherRoomsByAccounts")
 
 	roomRep := r.CreateRepository(app.GetDB())
 
-	roomIds, err := roomRep.CloseRoomsByAccounts(accountIds)
+	roomIds, err := roomRep.CloseRoomsByAccounts(accountIds, app.Instance.Env.MultipleOpenRoomTypes(), exceptRoomId)
 	if err != nil {
 		return err
 	}
 
-	ws.hub.roomMutex.Lock()
-	defer ws.hub.roomMutex.Unlock()
-
-	for _, roomId := range roomIds {
-		delete(ws.hub.rooms, roomId)
-	}
+	ws.sendRoomClosedMessage(roomIds, RoomCloseReasonNewRoom)
+	auditRoomsClosed(uuid.Nil, roomIds, RoomCloseReasonNewRoom)
 
 	return nil
 
@@ -162,27 +419,281 @@ func (ws *WsServer) CloseRoom(request *CloseRoomRequest) (*CloseRoomResponse, *s
 		}
 	}
 
-	rooms, err := roomRep.GetRooms(&r.GetRoomCriteria{ReferenceId: request.ReferenceId, RoomId: request.RoomId})
+	rooms, err := roomRep.GetRooms(&r.GetRoomCriteria{
+		ReferenceId:     request.ReferenceId,
+		RoomId:          request.RoomId,
+		WithSubscribers: request.InitiatorAccountId != uuid.Nil,
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	for _, room := range rooms {
-		err := roomRep.CloseRoom(room.Id)
+		err := checkInitiatorCapability(&room, request.InitiatorAccountId, CapabilityClose)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	ws.hub.roomMutex.Lock()
-	defer ws.hub.roomMutex.Unlock()
+	for _, room := range rooms {
+		err := roomRep.CloseRoom(room.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
 
+	var roomIds []uuid.UUID
 	for _, room := range rooms {
-		delete(ws.hub.rooms, room.Id)
+		roomIds = append(roomIds, room.Id)
+	}
+	ws.sendRoomClosedMessage(roomIds, RoomCloseReasonManual)
+	auditRoomsClosed(request.InitiatorAccountId, roomIds, RoomCloseReasonManual)
+
+	return response, nil
+
+}
+
+// UpdateRoom updates the room's metadata and notifies connected subscribers
+func (ws *WsServer) UpdateRoom(request *UpdateRoomRequest) (*UpdateRoomResponse, *system.Error) {
+
+	defer app.E().CatchPanic("UpdateRoom")
+
+	roomRep := r.CreateRepository(app.GetDB())
+
+	if request.ReferenceId == "" && request.RoomId == uuid.Nil {
+		return nil, system.SysErr(nil, system.IncorrectRequestCode, nil)
+	}
+
+	rooms, err := roomRep.GetRooms(&r.GetRoomCriteria{
+		ReferenceId:     request.ReferenceId,
+		RoomId:          request.RoomId,
+		WithClosed:      true,
+		WithSubscribers: request.InitiatorAccountId != uuid.Nil,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rooms) == 0 {
+		if request.RoomId != uuid.Nil {
+			return nil, system.SysErrf(nil, system.NoRoomFoundByIdCode, nil, request.RoomId.String())
+		}
+		return nil, system.SysErrf(nil, system.NoRoomFoundByReferenceCode, nil, request.ReferenceId)
+	}
+
+	room := &rooms[0]
+
+	err = checkInitiatorCapability(room, request.InitiatorAccountId, CapabilityEditRoom)
+	if err != nil {
+		return nil, err
+	}
+
+	before := auditRoomState(room)
+
+	// only the passed fields are changed
+	if request.Title != nil {
+		room.Title = *request.Title
+	}
+	if request.Description != nil {
+		room.Description = *request.Description
+	}
+	if request.AvatarUrl != nil {
+		room.AvatarUrl = *request.AvatarUrl
+	}
+	if request.Tags != nil {
+		room.Tags = roomTagsToString(*request.Tags)
+	}
+	if len(request.Attributes) > 0 {
+		room.Attributes, err = roomAttributes(request.Attributes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = roomRep.UpdateRoomMetadata(room)
+	if err != nil {
+		return nil, err
+	}
+
+	writeAudit(request.InitiatorAccountId, AuditActionRoomUpdate, AuditTargetRoom, room.Id, before, auditRoomState(room))
+
+	ws.hub.SendMessageToRoom(&RoomMessage{
+		RoomId: room.Id,
+		Message: &WSChatResponse{
+			Type: EventRoomUpdated,
+			Data: &WSRoomUpdatedDataResponse{
+				RoomId:      room.Id,
+				Title:       room.Title,
+				Description: room.Description,
+				AvatarUrl:   room.AvatarUrl,
+				Attributes:  payloadToRaw(room.Attributes),
+				Tags:        stringListFromString(room.Tags),
+			},
+		},
+	})
+
+	response := &UpdateRoomResponse{
+		Errors: []ErrorResponse{},
+	}
+
+	return response, nil
+}
+
+// getClosedRoom retrieves the closed room by Id or reference
+func getClosedRoom(roomId uuid.UUID, referenceId string) (*r.Room, *system.Error) {
+
+	if referenceId == "" && roomId == uuid.Nil {
+		return nil, system.SysErr(nil, system.IncorrectRequestCode, nil)
+	}
+
+	rooms, err := r.CreateRepository(app.GetDB()).GetRooms(&r.GetRoomCriteria{
+		ReferenceId:     referenceId,
+		RoomId:          roomId,
+		WithClosed:      true,
+		WithArchived:    true,
+		WithSubscribers: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rooms) == 0 {
+		if roomId != uuid.Nil {
+			return nil, system.SysErrf(nil, system.NoRoomFoundByIdCode, nil, roomId.String())
+		}
+		return nil, system.SysErrf(nil, system.NoRoomFoundByReferenceCode, nil, referenceId)
+	}
+
+	room := &rooms[0]
+	if room.ClosedAt == nil {
+		return nil, system.SysErrf(nil, system.RoomNotClosedCode, nil, room.Id.String())
+	}
+
+	return room, nil
+}
+
+// ReopenRoom opens the closed room again if it's closed not long ago and its subscribers are still active
+func (ws *WsServer) ReopenRoom(request *ReopenRoomRequest) (*ReopenRoomResponse, *system.Error) {
+
+	defer app.E().CatchPanic("ReopenRoom")
+
+	roomRep := r.CreateRepository(app.GetDB())
+	accRep := a.CreateRepository(app.GetDB())
+
+	room, err := getClosedRoom(request.RoomId, request.ReferenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if room.ArchivedAt != nil {
+		return nil, system.SysErrf(nil, system.RoomArchivedCode, nil, room.Id.String())
+	}
+
+	period := app.Instance.Env.RoomReopenPeriod()
+	if period > 0 && room.ClosedAt.Before(time.Now().Add(-period)) {
+		return nil, system.SysErrf(nil, system.RoomReopenPeriodExpiredCode, nil, room.Id.String())
+	}
+
+	err = checkInitiatorCapability(room, request.InitiatorAccountId, CapabilityClose)
+	if err != nil {
+		return nil, err
+	}
+
+	var subscribers []r.RoomSubscriber
+	var accountIds []uuid.UUID
+	for _, s := range room.Subscribers {
+
+		if s.UnsubscribeAt != nil {
+			continue
+		}
+
+		account, err := accRep.GetAccount(s.AccountId, "")
+		if err != nil {
+			return nil, err
+		}
+		if account.Status != AccountStatusActive {
+			return nil, system.SysErrf(nil, system.AccountNotActiveCode, nil, s.AccountId.String())
+		}
+
+		subscribers = append(subscribers, s)
+
+		if !system.Uint8ToBool(s.Observer) && !app.Instance.Env.MultipleOpenRooms(s.Role) {
+			accountIds = append(accountIds, s.AccountId)
+		}
+	}
+
+	if len(subscribers) == 0 {
+		return nil, system.SysErrf(nil, system.RoomReopenSubscribersCode, nil, room.Id.String())
+	}
+
+	// the same policy as for a new room
+	if !multipleOpenRoomType(room.Type) {
+		err := ws.CloseRoomsByAccounts(accountIds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reopened, err := roomRep.ReopenRoom(room.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !reopened {
+		return nil, system.SysErrf(nil, system.RoomNotClosedCode, nil, room.Id.String())
+	}
+
+	for _, s := range subscribers {
+		ws.sendRoomSubscribeMessage(room.Id, s.AccountId, s.Role)
+	}
+
+	writeAudit(request.InitiatorAccountId, AuditActionRoomReopen, AuditTargetRoom, room.Id, auditRoomState(room), nil)
+
+	err = ws.sendRoomSystemMessage(room.Id, RoomReopenedText, map[string]string{
+		"event": EventRoomReopened,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReopenRoomResponse{
+		Errors: []ErrorResponse{},
 	}
 
 	return response, nil
+}
+
+// ArchiveRoom archives the closed room, its history is still available
+func (ws *WsServer) ArchiveRoom(request *ArchiveRoomRequest) (*ArchiveRoomResponse, *system.Error) {
+
+	defer app.E().CatchPanic("ArchiveRoom")
+
+	roomRep := r.CreateRepository(app.GetDB())
+
+	room, err := getClosedRoom(request.RoomId, request.ReferenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkInitiatorCapability(room, request.InitiatorAccountId, CapabilityClose)
+	if err != nil {
+		return nil, err
+	}
+
+	archived, err := roomRep.ArchiveRoom(room.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !archived {
+		return nil, system.SysErrf(nil, system.RoomArchivedCode, nil, room.Id.String())
+	}
+
+	writeAudit(request.InitiatorAccountId, AuditActionRoomArchive, AuditTargetRoom, room.Id, nil, nil)
+
+	response := &ArchiveRoomResponse{
+		Errors: []ErrorResponse{},
+	}
 
+	return response, nil
 }
 
 func (ws *WsServer) RoomSubscribe(request *RoomSubscribeRequest) (*RoomSubscribeResponse, *system.Error) {
@@ -236,6 +747,41 @@ func (ws *WsServer) RoomSubscribe(request *RoomSubscribeRequest) (*RoomSubscribe
 
 	// TODO: max number of subscribers isn't exceeded
 
+	err = checkRolesExist(request.Subscribers)
+	if err != nil {
+		return nil, err
+	}
+
+	if !request.Internal {
+
+		// subscribers of a channel are managed by its admins only
+		if room.Type == RoomTypeChannel && request.InitiatorAccountId == uuid.Nil {
+			return nil, system.SysErrf(nil, system.InitiatorRequiredCode, nil, room.Type)
+		}
+
+		err = checkInitiatorCapability(room, request.InitiatorAccountId, CapabilityInvite)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if room.Type == RoomTypeDirect {
+		participants := 0
+		for _, s := range room.Subscribers {
+			if s.UnsubscribeAt == nil && !system.Uint8ToBool(s.Observer) {
+				participants++
+			}
+		}
+		for _, s := range request.Subscribers {
+			if !s.AsObserver {
+				participants++
+			}
+		}
+		if participants > 2 {
+			return nil, system.SysErr(nil, system.DirectRoomSubscribersCode, nil)
+		}
+	}
+
 	// go through requested subscribers
 	for _, subscribeRq := range request.Subscribers {
 
@@ -267,10 +813,15 @@ func (ws *WsServer) RoomSubscribe(request *RoomSubscribeRequest) (*RoomSubscribe
 
 		if !accountFound {
 
-			// close previous rooms for the account
-			err := ws.CloseRoomsByAccounts([]uuid.UUID{account.Id})
-			if err != nil {
-				return nil, err
+			role := subscriberRole(subscribeRq)
+
+			// close previous rooms for the account unless it's an observer, the role or the room type allows several open rooms
+			// or the room is assigned from the queue
+			if !request.KeepOpenRooms && !subscribeRq.AsObserver && !app.Instance.Env.MultipleOpenRooms(role) && !multipleOpenRoomType(room.Type) {
+				err := ws.CloseRoomsByAccounts([]uuid.UUID{account.Id})
+				if err != nil {
+					return nil, err
+				}
 			}
 
 			// add subscriber to DB
@@ -278,8 +829,9 @@ func (ws *WsServer) RoomSubscribe(request *RoomSubscribeRequest) (*RoomSubscribe
 				Id:            system.Uuid(),
 				RoomId:        room.Id,
 				AccountId:     account.Id,
-				Role:          subscribeRq.Role,
+				Role:          role,
 				SystemAccount: system.BoolToUint8(subscribeRq.AsSystemAccount),
+				Observer:      system.BoolToUint8(subscribeRq.AsObserver),
 			}
 
 			room.Subscribers = append(room.Subscribers, subscriber)
@@ -288,7 +840,13 @@ func (ws *WsServer) RoomSubscribe(request *RoomSubscribeRequest) (*RoomSubscribe
 				return nil, err
 			}
 
-			go ws.sendRoomSubscribeMessage(room.Id, account.Id, subscribeRq.Role)
+			go ws.sendRoomSubscribeMessage(room.Id, account.Id, role)
+
+			writeAudit(request.InitiatorAccountId, AuditActionRoomSubscribe, AuditTargetRoom, room.Id, nil, &AuditSubscriberState{
+				AccountId: account.Id,
+				Role:      role,
+				Observer:  subscribeRq.AsObserver,
+			})
 
 		}
 
@@ -319,77 +877,394 @@ func (ws *WsServer) RoomUnsubscribe(request *RoomUnsubscribeRequest) (*RoomUnsub
 	var err = &system.Error{}
 	if request.RoomId != uuid.Nil {
 
-		// search bu Id if provided
-		room, err := roomRep.GetRoom(request.RoomId)
+		// search bu Id if provided
+		room, err := roomRep.GetRoom(request.RoomId)
+		if err != nil {
+			return nil, err
+		}
+
+		if room != nil {
+			rooms = append(rooms, *room)
+			app.L().Debugf("Room unsubscribe. Room found %s by roomId.", room.Id.String())
+		} else {
+			return nil, system.SysErrf(nil, system.NoRoomFoundByIdCode, nil, request.RoomId.String())
+		}
+
+	} else if request.ReferenceId != "" {
+		// search by Reference Id if provided
+		rooms, err = roomRep.GetRooms(&r.GetRoomCriteria{ReferenceId: request.ReferenceId, WithSubscribers: true})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rooms) == 0 {
+			return nil, system.SysErrf(nil, system.NoRoomFoundByReferenceCode, nil, request.ReferenceId)
+		}
+
+		app.L().Debugf("Room unsubscribe. %d room(s) found by referenceId %s", len(rooms), request.ReferenceId)
+
+	} else {
+		return nil, system.SysErr(nil, system.IncorrectRequestCode, nil)
+	}
+
+	// get account
+	account, err := accRep.GetAccount(request.AccountId.AccountId, request.AccountId.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+
+	// go through all rooms
+	for _, room := range rooms {
+
+		// check if room isn't closed
+		if room.ClosedAt != nil {
+			return nil, system.SysErr(nil, system.RoomAlreadyClosedCode, []byte(request.RoomId.String()))
+		}
+
+		// anyone can leave a room, removing others requires the kick capability
+		if request.InitiatorAccountId != account.Id {
+			err := checkInitiatorCapability(&room, request.InitiatorAccountId, CapabilityKick)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		accountFound := false
+
+		// go through requested subscribers
+		for _, subscriber := range room.Subscribers {
+
+			if subscriber.AccountId == account.Id {
+
+				accountFound = true
+
+				err := roomRep.RoomUnsubscribeAccount(room.Id, account.Id)
+				if err != nil {
+					return nil, err
+				}
+
+				go ws.sendRoomUnsubscribeMessage(room.Id, account.Id)
+
+				writeAudit(request.InitiatorAccountId, AuditActionRoomUnsubscribe, AuditTargetRoom, room.Id, &AuditSubscriberState{
+					AccountId: account.Id,
+					Role:      subscriber.Role,
+					Observer:  system.Uint8ToBool(subscriber.Observer),
+				}, nil)
+
+			}
+
+		}
+
+		if !accountFound {
+			return nil, system.SysErrf(nil, system.NotSubscribedAccountCode, nil, room.Id.String(), account.Id.String())
+		}
+	}
+
+	return response, nil
+
+}
+
+// TransferRoom hands the room over from one account to another keeping the room open and its history
+func (ws *WsServer) TransferRoom(request *TransferRoomRequest) (*TransferRoomResponse, *system.Error) {
+
+	defer app.E().CatchPanic("TransferRoom")
+
+	roomRep := r.CreateRepository(app.GetDB())
+	accRep := a.CreateRepository(app.GetDB())
+
+	if request.RoomId == uuid.Nil {
+		return nil, system.SysErr(nil, system.IncorrectRequestCode, nil)
+	}
+
+	room, err := roomRep.GetRoom(request.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	if room == nil || room.Id == uuid.Nil {
+		return nil, system.SysErrf(nil, system.NoRoomFoundByIdCode, nil, request.RoomId.String())
+	}
+
+	if room.ClosedAt != nil {
+		return nil, system.SysErr(nil, system.RoomAlreadyClosedCode, []byte(room.Id.String()))
+	}
+
+	fromAccount, err := accRep.GetAccount(request.FromAccount.AccountId, request.FromAccount.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+
+	toAccount, err := accRep.GetAccount(request.ToAccount.AccountId, request.ToAccount.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Id == toAccount.Id {
+		return nil, system.SysErr(nil, system.RoomTransferSameAccountCode, nil)
+	}
+
+	if toAccount.Status != AccountStatusActive {
+		return nil, system.SysErrf(nil, system.AccountNotActiveCode, nil, toAccount.Id.String())
+	}
+
+	subscribers, err := roomRep.GetRoomSubscribers(room.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var fromSubscriber *r.RoomSubscriber
+	for i, s := range subscribers {
+		if s.UnsubscribeAt != nil {
+			continue
+		}
+		if s.AccountId == toAccount.Id {
+			return nil, system.SysErrf(nil, system.AccountAlreadySubscribedCode, nil, toAccount.Id.String(), room.Id.String())
+		}
+		// observers are promoted rather than transferred
+		if s.AccountId == fromAccount.Id && !system.Uint8ToBool(s.Observer) {
+			fromSubscriber = &subscribers[i]
+		}
+	}
+
+	if fromSubscriber == nil {
+		return nil, system.SysErrf(nil, system.NotSubscribedAccountCode, nil, fromAccount.Id.String(), room.Id.String())
+	}
+
+	// the new account takes the role of the previous one
+	toSubscriber := &r.RoomSubscriber{
+		Id:            system.Uuid(),
+		RoomId:        room.Id,
+		AccountId:     toAccount.Id,
+		Role:          fromSubscriber.Role,
+		SystemAccount: fromSubscriber.SystemAccount,
+	}
+
+	err = roomRep.TransferSubscriber(room.Id, fromAccount.Id, toSubscriber)
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.CreateRepository(app.GetDB()).TransferItem(room.Id, toAccount.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	ws.sendRoomUnsubscribeMessage(room.Id, fromAccount.Id)
+	ws.sendRoomSubscribeMessage(room.Id, toAccount.Id, toSubscriber.Role)
+
+	writeAudit(request.InitiatorAccountId, AuditActionRoomTransfer, AuditTargetRoom, room.Id,
+		&AuditSubscriberState{AccountId: fromAccount.Id, Role: fromSubscriber.Role},
+		&AuditSubscriberState{AccountId: toAccount.Id, Role: toSubscriber.Role})
+
+	transferred := &WSChatResponse{
+		Type: EventRoomTransferred,
+		Data: &WSRoomTransferredDataResponse{
+			RoomId:        room.Id,
+			FromAccountId: fromAccount.Id,
+			ToAccountId:   toAccount.Id,
+			Role:          toSubscriber.Role,
+		},
+	}
+	for _, accountId := range []uuid.UUID{fromAccount.Id, toAccount.Id} {
+		ws.hub.SendMessageToRoom(&RoomMessage{AccountId: accountId, Message: transferred})
+	}
+
+	err = ws.sendRoomSystemMessage(room.Id, request.Reason, map[string]string{
+		"event":         EventRoomTransferred,
+		"fromAccountId": fromAccount.Id.String(),
+		"toAccountId":   toAccount.Id.String(),
+		"role":          toSubscriber.Role,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TransferRoomResponse{
+		Errors: []ErrorResponse{},
+	}
+
+	return response, nil
+}
+
+// PromoteObserver turns the observer into a regular subscriber so that it becomes visible and is allowed to send messages
+func (ws *WsServer) PromoteObserver(request *PromoteObserverRequest) (*PromoteObserverResponse, *system.Error) {
+
+	defer app.E().CatchPanic("PromoteObserver")
+
+	roomRep := r.CreateRepository(app.GetDB())
+	accRep := a.CreateRepository(app.GetDB())
+
+	if request.RoomId == uuid.Nil {
+		return nil, system.SysErr(nil, system.IncorrectRequestCode, nil)
+	}
+
+	role := request.Role
+	if role == "" {
+		role = RoleMember
+	}
+
+	err := checkRoleExists(role)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := roomRep.GetRoom(request.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	if room.ClosedAt != nil {
+		return nil, system.SysErr(nil, system.RoomAlreadyClosedCode, []byte(room.Id.String()))
+	}
+
+	err = checkInitiatorCapability(room, request.InitiatorAccountId, CapabilityInvite)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := accRep.GetAccount(request.Account.AccountId, request.Account.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+
+	promoted, err := roomRep.PromoteObserver(room.Id, account.Id, role)
+	if err != nil {
+		return nil, err
+	}
+
+	if !promoted {
+		return nil, system.SysErrf(nil, system.ObserverNotFoundCode, nil, account.Id.String(), room.Id.String())
+	}
+
+	// the promoted account follows the same rules as a newly subscribed one
+	if !app.Instance.Env.MultipleOpenRooms(role) && !multipleOpenRoomType(room.Type) {
+		err := ws.closeOtherRoomsByAccounts([]uuid.UUID{account.Id}, room.Id)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		if room != nil {
-			rooms = append(rooms, *room)
-			app.L().Debugf("Room unsubscribe. Room found %s by roomId.", room.Id.String())
-		} else {
-			return nil, system.SysErrf(nil, system.NoRoomFoundByIdCode, nil, request.RoomId.String())
-		}
+	// refresh the room's subscribers on all nodes
+	ws.sendRoomSubscribeMessage(room.Id, account.Id, role)
 
-	} else if request.ReferenceId != "" {
-		// search by Reference Id if provided
-		rooms, err = roomRep.GetRooms(&r.GetRoomCriteria{ReferenceId: request.ReferenceId, WithSubscribers: true})
-		if err != nil {
-			return nil, err
-		}
+	response := &PromoteObserverResponse{
+		Errors: []ErrorResponse{},
+	}
 
-		if len(rooms) == 0 {
-			return nil, system.SysErrf(nil, system.NoRoomFoundByReferenceCode, nil, request.ReferenceId)
-		}
+	return response, nil
+}
 
-		app.L().Debugf("Room unsubscribe. %d room(s) found by referenceId %s", len(rooms), request.ReferenceId)
+// JoinRoomByHash creates an anonymous account and subscribes it on the room found by the hash
+func (ws *WsServer) JoinRoomByHash(request *JoinRoomByHashRequest) (*JoinRoomByHashResponse, *system.Error) {
 
-	} else {
+	defer app.E().CatchPanic("JoinRoomByHash")
+
+	roomRep := r.CreateRepository(app.GetDB())
+
+	if request.Hash == "" {
 		return nil, system.SysErr(nil, system.IncorrectRequestCode, nil)
 	}
 
-	// get account
-	account, err := accRep.GetAccount(request.AccountId.AccountId, request.AccountId.ExternalId)
+	room, err := roomRep.GetRoomByHash(request.Hash)
 	if err != nil {
 		return nil, err
 	}
 
-	// go through all rooms
-	for _, room := range rooms {
-
-		// check if room isn't closed
-		if room.ClosedAt != nil {
-			return nil, system.SysErr(nil, system.RoomAlreadyClosedCode, []byte(request.RoomId.String()))
-		}
-
-		accountFound := false
+	if room == nil {
+		return nil, system.SysErr(nil, system.RoomHashNotFoundCode, nil)
+	}
 
-		// go through requested subscribers
-		for _, subscriber := range room.Subscribers {
+	if room.ClosedAt != nil {
+		return nil, system.SysErr(nil, system.RoomAlreadyClosedCode, []byte(room.Id.String()))
+	}
 
-			if subscriber.AccountId == account.Id {
+	if room.HashExpiresAt != nil && room.HashExpiresAt.Before(time.Now()) {
+		return nil, system.SysErr(nil, system.RoomHashExpiredCode, nil)
+	}
 
-				accountFound = true
+	role := app.Instance.Env.AnonymousRole()
+	err = checkRoleExists(role)
+	if err != nil {
+		return nil, err
+	}
 
-				err := roomRep.RoomUnsubscribeAccount(room.Id, account.Id)
-				if err != nil {
-					return nil, err
-				}
+	token, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
 
-				go ws.sendRoomUnsubscribeMessage(room.Id, account.Id)
+	// the one-time link is claimed before the account is created, so a visitor losing the race leaves nothing behind
+	oneTime := system.Uint8ToBool(room.HashOneTime)
+	if oneTime {
+		used, err := roomRep.UseRoomHash(room.Id)
+		if err != nil {
+			return nil, err
+		}
+		if !used {
+			return nil, system.SysErr(nil, system.RoomHashExpiredCode, nil)
+		}
+	}
 
+	accountId, err := ws.joinRoomAnonymously(room.Id, role, request)
+	if err != nil {
+		// the visitor hasn't joined, so the link can be used again
+		if oneTime {
+			if releaseErr := roomRep.ReleaseRoomHash(room.Id); releaseErr != nil {
+				app.E().SetError(releaseErr)
 			}
-
 		}
+		return nil, err
+	}
 
-		if !accountFound {
-			return nil, system.SysErrf(nil, system.NotSubscribedAccountCode, nil, room.Id.String(), account.Id.String())
-		}
+	err = a.CreateRepository(app.GetDB()).SetSessionToken(token, accountId, app.Instance.Env.AnonymousTokenTtl())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &JoinRoomByHashResponse{
+		RoomId:    room.Id,
+		AccountId: accountId,
+		// WebSocket connection of the anonymous account is identified by the token
+		Token:  token,
+		Errors: []ErrorResponse{},
 	}
 
 	return response, nil
+}
+
+// joinRoomAnonymously creates an anonymous account and subscribes it on the room
+func (ws *WsServer) joinRoomAnonymously(roomId uuid.UUID, role string, request *JoinRoomByHashRequest) (uuid.UUID, *system.Error) {
+
+	accountRs, err := ws.createAccount(&CreateAccountRequest{
+		Account:   "anonymous",
+		Type:      AccountTypeAnonymousUser,
+		FirstName: request.FirstName,
+		LastName:  request.LastName,
+		Email:     request.Email,
+		Phone:     request.Phone,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if len(accountRs.Errors) > 0 {
+		return uuid.Nil, &system.Error{Message: accountRs.Errors[0].Message, Code: accountRs.Errors[0].Code}
+	}
+
+	_, err = ws.RoomSubscribe(&RoomSubscribeRequest{
+		RoomId:   roomId,
+		Internal: true,
+		Subscribers: []SubscriberRequest{
+			{
+				Account: &AccountIdRequest{AccountId: accountRs.AccountId},
+				Role:    role,
+			},
+		},
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
 
+	return accountRs.AccountId, nil
 }
 
 func (ws *WsServer) GetRoomsByCriteria(request *GetRoomsByCriteriaRequest) (*GetRoomsByCriteriaResponse, *system.Error) {
@@ -404,7 +1279,9 @@ func (ws *WsServer) GetRoomsByCriteria(request *GetRoomsByCriteriaRequest) (*Get
 		ReferenceId:       request.ReferenceId,
 		RoomId:            request.RoomId,
 		WithClosed:        request.WithClosed,
+		WithArchived:      request.WithArchived,
 		WithSubscribers:   request.WithSubscribers,
+		Tags:              roomTagsToString(request.Tags),
 	}
 
 	result, err := roomRep.GetRooms(criteriaModel)
@@ -412,6 +1289,16 @@ func (ws *WsServer) GetRoomsByCriteria(request *GetRoomsByCriteriaRequest) (*Get
 		return nil, err
 	}
 
+	var roomIds []uuid.UUID
+	for _, item := range result {
+		roomIds = append(roomIds, item.Id)
+	}
+
+	pins, err := roomRep.GetPinnedMessages(roomIds)
+	if err != nil {
+		return nil, err
+	}
+
 	response := &GetRoomsByCriteriaResponse{
 		Rooms:  []GetRoomResponse{},
 		Errors: []ErrorResponse{},
@@ -427,7 +1314,17 @@ func (ws *WsServer) GetRoomsByCriteria(request *GetRoomsByCriteriaRequest) (*Get
 			Video:       system.Uint8ToBool(item.Video),
 			Audio:       system.Uint8ToBool(item.Audio),
 			ClosedAt:    item.ClosedAt,
+			ArchivedAt:  item.ArchivedAt,
+			Title:       item.Title,
+			Description: item.Description,
+			AvatarUrl:   item.AvatarUrl,
+			Attributes:  payloadToRaw(item.Attributes),
+			Tags:        stringListFromString(item.Tags),
+			MessageTtl:  item.MessageTtl,
+			Queue:       item.Queue,
+			Type:        item.Type,
 			Subscribers: []GetSubscriberResponse{},
+			Pins:        pinnedMessagesFromModel(pins[item.Id]),
 		}
 		if request.WithSubscribers {
 			for _, s := range item.Subscribers {
@@ -436,6 +1333,7 @@ func (ws *WsServer) GetRoomsByCriteria(request *GetRoomsByCriteriaRequest) (*Get
 					AccountId:     s.AccountId,
 					Role:          s.Role,
 					UnSubscribeAt: s.UnsubscribeAt,
+					Observer:      system.Uint8ToBool(s.Observer),
 				})
 			}
 		}
@@ -447,6 +1345,151 @@ func (ws *WsServer) GetRoomsByCriteria(request *GetRoomsByCriteriaRequest) (*Get
 
 }
 
+const (
+	AccountRoomsDefaultLimit = 20
+	AccountRoomsMaxLimit     = 100
+)
+
+// encodeAccountRoomsCursor builds an opaque cursor pointing after the room
+func encodeAccountRoomsCursor(room *r.AccountRoom) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d|%s", room.LastActivityAt.UnixNano(), room.Id.String())))
+}
+
+func decodeAccountRoomsCursor(cursor string) (*r.AccountRoomsCursor, *system.Error) {
+
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, system.SysErr(err, system.RoomsCursorInvalidCode, nil)
+	}
+
+	parts := strings.SplitN(string(b), "|", 2)
+	if len(parts) != 2 {
+		return nil, system.SysErr(nil, system.RoomsCursorInvalidCode, nil)
+	}
+
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, system.SysErr(err, system.RoomsCursorInvalidCode, nil)
+	}
+
+	roomId, err := uuid.FromString(parts[1])
+	if err != nil {
+		return nil, system.SysErr(err, system.RoomsCursorInvalidCode, nil)
+	}
+
+	// activity times are stored without a time zone, so they are kept in UTC
+	return &r.AccountRoomsCursor{ActivityAt: time.Unix(0, nanos).UTC(), RoomId: roomId}, nil
+}
+
+// GetAccountRooms returns the account's rooms sorted by the last activity with the last message preview and unread count
+func (ws *WsServer) GetAccountRooms(request *GetAccountRoomsRequest) (*GetAccountRoomsResponse, *system.Error) {
+
+	defer app.E().CatchPanic("GetAccountRooms")
+
+	account, err := a.CreateRepository(app.GetDB()).GetAccount(request.AccountId.AccountId, request.AccountId.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil || account.Id == uuid.Nil {
+		return nil, system.SysErrf(nil, system.AccountNotFoundById, nil, accountIdRequestKey(&request.AccountId))
+	}
+
+	limit := request.Limit
+	if limit <= 0 {
+		limit = AccountRoomsDefaultLimit
+	}
+	if limit > AccountRoomsMaxLimit {
+		limit = AccountRoomsMaxLimit
+	}
+
+	criteria := &r.GetAccountRoomsCriteria{
+		AccountId:  account.Id,
+		WithClosed: request.WithClosed,
+		// one extra room tells if there is the next page
+		Limit:      limit + 1,
+	}
+
+	if request.Cursor != "" {
+		criteria.Before, err = decodeAccountRoomsCursor(request.Cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	roomRep := r.CreateRepository(app.GetDB())
+
+	rooms, err := roomRep.GetAccountRooms(criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAccountRoomsResponse{
+		Rooms:  []AccountRoom{},
+		Errors: []ErrorResponse{},
+	}
+
+	if len(rooms) > limit {
+		rooms = rooms[:limit]
+		response.NextCursor = encodeAccountRoomsCursor(&rooms[limit-1])
+	}
+
+	var roomIds []uuid.UUID
+	for _, item := range rooms {
+		roomIds = append(roomIds, item.Id)
+	}
+
+	subscribers, err := roomRep.GetSubscribersByRooms(roomIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range rooms {
+
+		room := AccountRoom{
+			Id:             item.Id,
+			ReferenceId:    item.ReferenceId,
+			Type:           item.Type,
+			Title:          item.Title,
+			AvatarUrl:      item.AvatarUrl,
+			ClosedAt:       item.ClosedAt,
+			LastActivityAt: item.LastActivityAt,
+			UnreadCount:    item.UnreadCount,
+			Subscribers:    []GetSubscriberResponse{},
+		}
+
+		if item.LastMessageId != nil {
+			room.LastMessage = &AccountRoomLastMessage{
+				Id:              *item.LastMessageId,
+				Type:            *item.LastMessageType,
+				Text:            *item.LastMessageText,
+				SenderAccountId: *item.LastMessageAccountId,
+				CreatedAt:       *item.LastMessageAt,
+				Expired:         item.LastMessageExpired,
+				Deleted:         item.LastMessageDeleted,
+			}
+		}
+
+		for _, s := range subscribers[item.Id] {
+			// observers are visible only to themselves
+			if system.Uint8ToBool(s.Observer) && !uuid.Equal(s.AccountId, account.Id) {
+				continue
+			}
+			room.Subscribers = append(room.Subscribers, GetSubscriberResponse{
+				Id:            s.Id,
+				AccountId:     s.AccountId,
+				Role:          s.Role,
+				UnSubscribeAt: s.UnsubscribeAt,
+				Observer:      system.Uint8ToBool(s.Observer),
+			})
+		}
+
+		response.Rooms = append(response.Rooms, room)
+	}
+
+	return response, nil
+}
+
 func (ws *WsServer) GetMessageHistory(request *GetMessageHistoryRequest) (*GetMessageHistoryResponse, *system.Error) {
 
 	defer app.E().CatchPanic("GetMessageHistory")
@@ -463,6 +1506,11 @@ func (ws *WsServer) GetMessageHistory(request *GetMessageHistoryRequest) (*GetMe
 		}
 	}
 
+	// stars are personal, so the account must be specified explicitly
+	if request.Criteria.StarredOnly && request.Criteria.AccountId.AccountId == uuid.Nil {
+		return nil, system.SysErr(nil, system.IncorrectRequestCode, nil)
+	}
+
 	criteria := request.Criteria
 	criteriaModel := &r.GetMessageHistoryCriteria{
 		AccountId:         criteria.AccountId.AccountId,
@@ -476,6 +1524,7 @@ func (ws *WsServer) GetMessageHistory(request *GetMessageHistoryRequest) (*GetMe
 		SentOnly:          criteria.SentOnly,
 		ReceivedOnly:      criteria.ReceivedOnly,
 		WithAccounts:      criteria.WithAccounts,
+		StarredOnly:       criteria.StarredOnly,
 	}
 
 	var pagingRqModel = &repository.PagingRequest{
@@ -520,12 +1569,20 @@ func (ws *WsServer) GetMessageHistory(request *GetMessageHistoryRequest) (*GetMe
 			ClientMessageId:    item.ClientMessageId,
 			ReferenceId:        item.ReferenceId,
 			RoomId:             item.RoomId,
+			RoomType:           item.RoomType,
 			Type:               item.Type,
 			Message:            item.Message,
 			FileId:             item.FileId,
 			Params:             item.Params,
+			Payload:            payloadToRaw(&item.Payload),
 			SenderAccountId:    item.SenderAccountId,
 			RecipientAccountId: item.RecipientAccountId,
+			Visibility:         item.Visibility,
+			VisibleRoles:       stringListFromString(item.VisibleRoles),
+			ForwardedFrom:      forwardedFrom(item.ForwardedMessageId, item.ForwardedRoomId, item.ForwardedAccountId, item.ForwardedAt),
+			ExpiresAt:          item.ExpiresAt,
+			Expired:            item.ExpiredAt != nil,
+			Deleted:            item.ModeratedAt != nil,
 			Statuses:           []MessageStatus{},
 		}
 
@@ -580,6 +1637,7 @@ func (ws *WsServer) SendChatMessages(request *SendChatMessagesRequest) (*SendCha
 	}
 
 	var roomId uuid.UUID
+	var room *r.Room
 	recipients := make(map[uuid.UUID]Account)
 	var subscribers []r.RoomSubscriber
 	var sysErr = &system.Error{}
@@ -588,12 +1646,33 @@ func (ws *WsServer) SendChatMessages(request *SendChatMessagesRequest) (*SendCha
 		if len(item.Text) > maxMessageSize {
 			return nil, system.SysErr(err, system.MessageTooLongErrorCode, rqJson)
 		}
+		if sysErr := validateMessagePayload(item.Type, item.Payload); sysErr != nil {
+			sysErr.Data = rqJson
+			return nil, sysErr
+		}
 		if item.RoomId == uuid.Nil {
 			return nil, system.SysErr(err, system.MysqlChatIdIncorrectCode, rqJson)
 		}
 
+		visibility, visibilityErr := messageVisibility(&item)
+		if visibilityErr != nil {
+			visibilityErr.Data = rqJson
+			return nil, visibilityErr
+		}
+
+		var visibleRoles []string
+		if visibility == MessageVisibilityRoles {
+			visibleRoles = item.VisibleRoles
+		}
+
 		if roomId == uuid.Nil {
 			roomId = item.RoomId
+
+			room, sysErr = roomRepository.GetRoom(roomId)
+			if sysErr != nil {
+				return nil, sysErr
+			}
+
 			subscribers, sysErr = roomRepository.GetRoomSubscribers(roomId)
 			if sysErr != nil {
 				return nil, sysErr
@@ -611,8 +1690,14 @@ func (ws *WsServer) SendChatMessages(request *SendChatMessagesRequest) (*SendCha
 
 		for _, s := range subscribers {
 
-			// add to list recipients all the accounts (including sender) except system account (bot)
-			if _, ok := recipients[s.AccountId]; !ok && !system.Uint8ToBool(s.SystemAccount) {
+			// observers are invisible to other subscribers and don't get message statuses
+			observer := system.Uint8ToBool(s.Observer)
+
+			// subscribers who can't see the message don't get its status
+			visible := roleVisible(visibleRoles, s.Role) || s.AccountId == request.SenderAccountId
+
+			// add to list recipients all the accounts (including sender) except system account (bot) and observers
+			if _, ok := recipients[s.AccountId]; !ok && !system.Uint8ToBool(s.SystemAccount) && !observer && visible {
 
 				account, err := accountRepository.GetAccount(s.AccountId, "")
 				if err != nil {
@@ -622,10 +1707,13 @@ func (ws *WsServer) SendChatMessages(request *SendChatMessagesRequest) (*SendCha
 			}
 
 			if s.AccountId == request.SenderAccountId {
+				if observer {
+					return nil, system.SysErrf(nil, system.ObserverCannotSendCode, rqJson, s.AccountId.String(), roomId.String())
+				}
 				senderAccountId = s.AccountId
 				senderSubscriberId = s.Id
 				senderSubscriberType = s.Role
-			} else {
+			} else if !observer && visible {
 				opponents = append(opponents, r.ChatOpponent{
 					SubscriberId:  s.Id,
 					AccountId:     s.AccountId,
@@ -638,12 +1726,77 @@ func (ws *WsServer) SendChatMessages(request *SendChatMessagesRequest) (*SendCha
 			return nil, system.SysErr(err, system.MysqlChatAccessDeniedCode, rqJson)
 		}
 
+		// only subscribers spend the room's limit, so outsiders can't block the room
+		if request.RateLimited {
+			if wait, limitErr := ws.rateLimiter.checkRoom(roomId); limitErr != nil {
+				ws.sendRateLimited(senderAccountId, roomId, item.ClientMessageId, wait, limitErr)
+				limitErr.Data = rqJson
+				return nil, limitErr
+			}
+		}
+
+		capability := CapabilitySend
+		if item.RecipientAccountId != uuid.Nil {
+			capability = CapabilitySendPrivate
+		}
+		if sysErr := checkSubscriberCapability(roomId, senderAccountId, senderSubscriberType, capability); sysErr != nil {
+			sysErr.Data = rqJson
+			return nil, sysErr
+		}
+
+		if room.Type == RoomTypeChannel {
+			if sysErr := checkSubscriberCapability(roomId, senderAccountId, senderSubscriberType, CapabilityPost); sysErr != nil {
+				sysErr.Data = rqJson
+				return nil, sysErr
+			}
+		}
+
+		// the sender must be able to see its own message in the history
+		if !roleVisible(visibleRoles, senderSubscriberType) {
+			return nil, system.SysErrf(nil, system.MessageVisibilityRoleCode, rqJson, senderSubscriberType)
+		}
+
 		if item.RecipientAccountId != uuid.Nil {
 			if _, ok := recipients[item.RecipientAccountId]; !ok {
 				return nil, system.SysErr(err, system.PrivateChatRecipientNotFoundAmongSubscribersCode, rqJson)
 			}
 		}
 
+		// the text is checked before it's stored, so neither scheduled nor sent messages bypass the moderation
+		if !request.Moderated {
+
+			moderation, moderationErr := ws.moderateMessage(senderAccountId, &item)
+			if moderationErr != nil {
+				return nil, moderationErr
+			}
+
+			switch moderation.Decision {
+			case app.ModerationReject:
+				ws.sendMessageRejected(senderAccountId, roomId, item.ClientMessageId, uuid.Nil, moderation.Error)
+				moderation.Error.Data = rqJson
+				return nil, moderation.Error
+			case app.ModerationHold:
+				heldId, holdErr := ws.holdMessage(senderAccountId, &item, moderation)
+				if holdErr != nil {
+					return nil, holdErr
+				}
+				response.HeldMessageIds = append(response.HeldMessageIds, heldId)
+				continue
+			}
+
+			item.Text = moderation.Text
+		}
+
+		// the message is stored and sent by the scheduler later on behalf of the sender
+		if item.SendAt != nil && item.SendAt.After(time.Now()) {
+			scheduledId, scheduleErr := scheduleMessage(senderAccountId, &item)
+			if scheduleErr != nil {
+				return nil, scheduleErr
+			}
+			response.ScheduledMessageIds = append(response.ScheduledMessageIds, scheduledId)
+			continue
+		}
+
 		paramsJson, err := json.Marshal(item.Params)
 		if err != nil {
 			return nil, system.SysErr(err, system.UnmarshallingErrorCode, nil)
@@ -657,11 +1810,30 @@ func (ws *WsServer) SendChatMessages(request *SendChatMessagesRequest) (*SendCha
 			Type:            item.Type,
 			SubscribeId:     senderSubscriberId,
 			Message:         item.Text,
+			FileId:          item.FileId,
 			Params:          string(paramsJson),
+			Payload:         payloadFromRaw(item.Payload),
+			Visibility:      visibility,
+			VisibleRoles:    stringListToString(visibleRoles),
 		}
 		if item.RecipientAccountId != uuid.Nil {
 			dbMessage.RecipientAccountId = &item.RecipientAccountId
 		}
+		// the message's own ttl takes precedence over the room's one
+		ttl := item.Ttl
+		if ttl <= 0 {
+			ttl = room.MessageTtl
+		}
+		if ttl > 0 {
+			expiresAt := time.Now().Add(time.Duration(ttl) * time.Second)
+			dbMessage.ExpiresAt = &expiresAt
+		}
+		if item.ForwardedFrom != nil {
+			dbMessage.ForwardedMessageId = &item.ForwardedFrom.MessageId
+			dbMessage.ForwardedRoomId = &item.ForwardedFrom.RoomId
+			dbMessage.ForwardedAccountId = &item.ForwardedFrom.AccountId
+			dbMessage.ForwardedAt = &item.ForwardedFrom.CreatedAt
+		}
 
 		sysErr = roomRepository.CreateMessage(dbMessage, opponents)
 		if sysErr != nil {
@@ -680,6 +1852,11 @@ func (ws *WsServer) SendChatMessages(request *SendChatMessagesRequest) (*SendCha
 			Text:               item.Text,
 			RecipientAccountId: item.RecipientAccountId,
 			Params:             item.Params,
+			Payload:            item.Payload,
+			VisibleRoles:       visibleRoles,
+			FileId:             item.FileId,
+			ForwardedFrom:      item.ForwardedFrom,
+			ExpiresAt:          dbMessage.ExpiresAt,
 		}
 
 		//if len(dbMessage.FileId) > 0 {
@@ -736,6 +1913,7 @@ func (ws *WsServer) SendChatMessages(request *SendChatMessagesRequest) (*SendCha
 						Accounts: recipientAccounts,
 					},
 				},
+				VisibleRoles: visibleRoles,
 			}
 
 			// send to internal NATS topic for balancing
@@ -748,6 +1926,110 @@ func (ws *WsServer) SendChatMessages(request *SendChatMessagesRequest) (*SendCha
 	return response, nil
 }
 
+func (ws *WsServer) ButtonClick(accountId uuid.UUID, request *WSChatButtonClickDataRequest) *system.Error {
+
+	defer app.E().CatchPanic("ButtonClick")
+
+	roomRep := r.CreateRepository(app.GetDB())
+
+	message, err := roomRep.GetMessage(request.MessageId)
+	if err != nil {
+		return err
+	}
+
+	// private messages can be clicked by the recipient only
+	if message == nil ||
+		message.RoomId != request.RoomId ||
+		(message.RecipientAccountId != nil && *message.RecipientAccountId != accountId) {
+		return system.SysErrf(nil, system.MessageNotFoundCode, nil, request.MessageId.String())
+	}
+
+	subscribers, err := roomRep.GetRoomSubscribers(message.RoomId)
+	if err != nil {
+		return err
+	}
+
+	clickerFound := false
+	clickerRole := ""
+	clickerObserver := false
+	senderIsSystem := false
+	for _, s := range subscribers {
+		// former subscribers can't click buttons
+		if s.AccountId == accountId && s.UnsubscribeAt == nil {
+			clickerFound = true
+			clickerRole = s.Role
+			clickerObserver = system.Uint8ToBool(s.Observer)
+		}
+		if s.AccountId == message.AccountId {
+			senderIsSystem = system.Uint8ToBool(s.SystemAccount)
+		}
+	}
+
+	if !clickerFound {
+		return system.SysErrf(nil, system.NotSubscribedAccountCode, nil, accountId.String(), message.RoomId.String())
+	}
+
+	if clickerObserver {
+		return system.SysErrf(nil, system.ObserverCannotSendCode, nil, accountId.String(), message.RoomId.String())
+	}
+
+	// a click is a reply on behalf of the clicker
+	err = checkSubscriberCapability(message.RoomId, accountId, clickerRole, CapabilitySend)
+	if err != nil {
+		return err
+	}
+
+	var payload string
+	if message.Payload != nil {
+		payload = *message.Payload
+	}
+
+	var button *MessageButton
+	buttons := messagePayloadButtons(message.Type, payload)
+	for i := range buttons {
+		if buttons[i].Id == request.ButtonId {
+			button = &buttons[i]
+			break
+		}
+	}
+
+	if button == nil {
+		return system.SysErrf(nil, system.MessageButtonNotFoundCode, nil, request.ButtonId, message.Id.String())
+	}
+
+	value := button.Value
+	if value == "" {
+		value = button.Id
+	}
+
+	response := &WSChatResponse{
+		Type: EventButtonClick,
+		Data: WSChatButtonClickDataResponse{
+			RoomId:    message.RoomId,
+			MessageId: message.Id,
+			AccountId: accountId,
+			ButtonId:  button.Id,
+			Value:     value,
+		},
+	}
+
+	// system accounts (bots) have no websocket connection, so the click goes to the bus
+	if senderIsSystem {
+		rs, e := json.Marshal(response)
+		if e != nil {
+			return system.MarshalError1011(e, nil)
+		}
+		return app.GetNats().Subject(ws.apiTopic).Publish(rs)
+	}
+
+	ws.hub.SendMessageToRoom(&RoomMessage{
+		AccountId: message.AccountId,
+		Message:   response,
+	})
+
+	return nil
+}
+
 func (ws *WsServer) resendRecdMessagesToSession(session *Session, roomId uuid.UUID) {
 
 	defer app.E().CatchPanic("resendRecdMessagesToSession")
@@ -764,10 +2046,18 @@ func (ws *WsServer) resendRecdMessagesToSession(session *Session, roomId uuid.UU
 		app.E().SetError(err)
 	}
 
+	subscriber, _ := session.getSubscriber(roomId)
+
 	if len(messages) > 0 {
 		app.L().Debugf("Messages to resend found: %s", len(messages))
 		for _, m := range messages {
 
+			// never resend internal notes to accounts without the roles
+			visibleRoles := stringListFromString(m.VisibleRoles)
+			if m.Visibility == MessageVisibilityRoles && m.AccountId != session.account.Id && !roleVisible(visibleRoles, subscriber.Role) {
+				continue
+			}
+
 			jsonParams := make(map[string]string)
 			if m.Params != "" {
 				err := json.Unmarshal([]byte(m.Params), &jsonParams)
@@ -796,6 +2086,11 @@ func (ws *WsServer) resendRecdMessagesToSession(session *Session, roomId uuid.UU
 							Text:               m.Message,
 							RecipientAccountId: recipientAccountId,
 							Params:             jsonParams,
+							Payload:            payloadToRaw(m.Payload),
+							VisibleRoles:       visibleRoles,
+							FileId:             m.FileId,
+							ForwardedFrom:      forwardedFrom(m.ForwardedMessageId, m.ForwardedRoomId, m.ForwardedAccountId, m.ForwardedAt),
+							ExpiresAt:          m.ExpiresAt,
 						}},
 				},
 			}
@@ -53,6 +53,13 @@ func SetRouter() *Router {
 	router.Handle(EventJoin, event.EventJoin)
 	router.Handle(EventTyping, event.EventTyping)
 	router.Handle(EventEcho, event.EventEcho)
+	router.Handle(EventButtonClick, event.EventButtonClick)
+	router.Handle(EventForwardMessages, event.EventForwardMessages)
+	router.Handle(EventPinMessage, event.EventPinMessage)
+	router.Handle(EventUnpinMessage, event.EventPinMessage)
+	router.Handle(EventStarMessage, event.EventStarMessage)
+	router.Handle(EventUnstarMessage, event.EventStarMessage)
+	router.Handle(EventReportMessage, event.EventReportMessage)
 
 	return router
 }
@@ -20,6 +20,8 @@ type WsServer struct {
 	grpcServer 			*grpc.Server
 	actualAccounts      map[uuid.UUID]time.Time
 	actualAccountsMutex sync.Mutex
+	moderation          *ModerationChain
+	rateLimiter         *RateLimiter
 }
 
 var wsServer = &WsServer{}
@@ -31,6 +33,8 @@ func NewServer(app *app.Application) *WsServer {
 		hub:            NewHub(),
 		shutdownSleep:  getShutdownSleep(),
 		actualAccounts: make(map[uuid.UUID]time.Time),
+		moderation:     newModerationChain(),
+		rateLimiter:    newRateLimiter(),
 	}
 	return wsServer
 }
@@ -47,6 +51,24 @@ func (ws *WsServer) Run() {
 		// push для непрочитанных сообщений
 		go ws.userServiceMessageManager()
 
+		// messages to rooms (e.g. subscribing assigned operators)
+		go ws.hub.Run()
+
+		// назначение операторов на комнаты в очереди
+		go ws.queueDispatcher()
+
+		// закрытие комнат по расписанию и неактивности
+		go ws.roomCloser()
+
+		// отправка отложенных сообщений
+		go ws.messageScheduler()
+
+		// удаление содержимого истекших сообщений
+		go ws.messageSweeper()
+
+		// удаление устаревших данных по политикам хранения
+		go ws.retentionPurger()
+
 		// переводит в offline
 		ws.consumer()
 
@@ -4,6 +4,7 @@ import (
 	"chats/app"
 	r "chats/repository/room"
 	"chats/system"
+	"encoding/json"
 	"fmt"
 	"github.com/gorilla/websocket"
 	uuid "github.com/satori/go.uuid"
@@ -24,6 +25,7 @@ type Session struct {
 	sendChan        chan []byte
 	sessionId       uuid.UUID
 	rooms           map[uuid.UUID]*Room
+	roomsMutex      sync.RWMutex
 	// TODO: remove link to repository
 	subscribers     map[uuid.UUID]r.AccountSubscriber
 	subscribesMutex sync.Mutex
@@ -130,6 +132,57 @@ func (c *Session) Read() {
 	}
 }
 
+// sendError sends the error event to the session only
+func (c *Session) sendError(data *WSErrorDataResponse) {
+
+	message, err := json.Marshal(&WSChatResponse{
+		Type: EventError,
+		Data: data,
+	})
+	if err != nil {
+		app.E().SetError(system.MarshalError1011(err, nil))
+		return
+	}
+
+	c.send(message)
+}
+
+func (c *Session) addRoom(room *Room) {
+	c.roomsMutex.Lock()
+	defer c.roomsMutex.Unlock()
+	c.rooms[room.roomId] = room
+}
+
+func (c *Session) removeRoom(roomId uuid.UUID) *Room {
+	c.roomsMutex.Lock()
+	defer c.roomsMutex.Unlock()
+
+	room, ok := c.rooms[roomId]
+	if ok {
+		delete(c.rooms, roomId)
+	}
+	return room
+}
+
+// getRooms returns a snapshot of the session rooms, so it's safe to iterate while rooms are added or removed
+func (c *Session) getRooms() []*Room {
+	c.roomsMutex.RLock()
+	defer c.roomsMutex.RUnlock()
+
+	rooms := make([]*Room, 0, len(c.rooms))
+	for _, room := range c.rooms {
+		rooms = append(rooms, room)
+	}
+	return rooms
+}
+
+func (c *Session) getSubscriber(roomId uuid.UUID) (r.AccountSubscriber, bool) {
+	c.subscribesMutex.Lock()
+	defer c.subscribesMutex.Unlock()
+	s, ok := c.subscribers[roomId]
+	return s, ok
+}
+
 func (c *Session) SetSubscribers(data map[uuid.UUID]r.AccountSubscriber) {
 	c.subscribesMutex.Lock()
 	defer c.subscribesMutex.Unlock()
@@ -1,7 +1,9 @@
 package server
 
 import (
+	"encoding/json"
 	uuid "github.com/satori/go.uuid"
+	"time"
 )
 
 // TODO: remove relations to sdk
@@ -30,6 +32,8 @@ type WSChatErrorResponse struct {
 type WSChatErrorErrorResponse struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
+	// milliseconds to wait before the next attempt (if rate limited)
+	RetryAfter int64 `json:"retryAfter,omitempty"`
 }
 
 //	message request
@@ -49,7 +53,12 @@ type WSChatMessageDataRequest struct {
 	Type               string            `json:"type"`
 	Text               string            `json:"text"`
 	Params             map[string]string `json:"params"`
+	Payload            json.RawMessage   `json:"payload"`
 	RecipientAccountId uuid.UUID        `json:"recipientAccountId"`
+	Visibility         string            `json:"visibility"`
+	VisibleRoles       []string          `json:"visibleRoles"`
+	SendAt             *time.Time        `json:"sendAt"`
+	Ttl                int64             `json:"ttl"`
 }
 
 //	message response
@@ -68,7 +77,12 @@ type WSChatMessagesDataMessageResponse struct {
 	Type               string            `json:"type"`
 	Text               string            `json:"text"`
 	Params             map[string]string `json:"params"`
+	Payload            json.RawMessage   `json:"payload,omitempty"`
 	RecipientAccountId uuid.UUID        `json:"recipientAccountId"`
+	VisibleRoles       []string          `json:"visibleRoles,omitempty"`
+	FileId             string            `json:"fileId,omitempty"`
+	ForwardedFrom      *ForwardedFrom    `json:"forwardedFrom,omitempty"`
+	ExpiresAt          *time.Time        `json:"expiresAt,omitempty"`
 }
 type WSChatMessagesDataMessageFileResponse struct {
 	WSChatMessagesDataMessageResponse
@@ -153,6 +167,137 @@ type WSChatTypingDataResponse struct {
 	Status    string    `json:"status"`
 }
 
+//	buttonClick request
+type WSChatButtonClickRequest struct {
+	Type string                       `json:"type"`
+	Data WSChatButtonClickDataRequest `json:"data"`
+}
+type WSChatButtonClickDataRequest struct {
+	RoomId    uuid.UUID `json:"roomId"`
+	MessageId uuid.UUID `json:"messageId"`
+	ButtonId  string    `json:"buttonId"`
+}
+
+//	buttonClick response (to the message sender only)
+type WSChatButtonClickDataResponse struct {
+	RoomId    uuid.UUID `json:"roomId"`
+	MessageId uuid.UUID `json:"messageId"`
+	AccountId uuid.UUID `json:"accountId"`
+	ButtonId  string    `json:"buttonId"`
+	Value     string    `json:"value"`
+}
+
+//	roomUpdated response (to the room's subscribers)
+type WSRoomUpdatedDataResponse struct {
+	RoomId      uuid.UUID       `json:"roomId"`
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	AvatarUrl   string          `json:"avatarUrl"`
+	Attributes  json.RawMessage `json:"attributes"`
+	Tags        []string        `json:"tags"`
+}
+
+type WSForwardMessagesRequest struct {
+	Type string                        `json:"type"`
+	Data WSForwardMessagesDataRequest `json:"data"`
+}
+type WSForwardMessagesDataRequest struct {
+	SourceRoomId uuid.UUID   `json:"sourceRoomId"`
+	TargetRoomId uuid.UUID   `json:"targetRoomId"`
+	MessageIds   []uuid.UUID `json:"messageIds"`
+}
+
+//	error response (e.g. a scheduled message hasn't been sent, a message has been rejected by the moderation or rate limited)
+type WSErrorDataResponse struct {
+	Code               int       `json:"code"`
+	Message            string    `json:"message"`
+	RoomId             uuid.UUID `json:"roomId"`
+	ScheduledMessageId uuid.UUID `json:"scheduledMessageId,omitempty"`
+	HeldMessageId      uuid.UUID `json:"heldMessageId,omitempty"`
+	ClientMessageId    string    `json:"clientMessageId,omitempty"`
+	// milliseconds to wait before the next attempt (if rate limited)
+	RetryAfter         int64     `json:"retryAfter,omitempty"`
+}
+
+type WSPinMessageRequest struct {
+	Type string                   `json:"type"`
+	Data WSPinMessageDataRequest `json:"data"`
+}
+type WSPinMessageDataRequest struct {
+	RoomId    uuid.UUID `json:"roomId"`
+	MessageId uuid.UUID `json:"messageId"`
+}
+
+type WSStarMessageRequest struct {
+	Type string                    `json:"type"`
+	Data WSStarMessageDataRequest `json:"data"`
+}
+type WSStarMessageDataRequest struct {
+	MessageId uuid.UUID `json:"messageId"`
+}
+
+type WSReportMessageRequest struct {
+	Type string                     `json:"type"`
+	Data WSReportMessageDataRequest `json:"data"`
+}
+type WSReportMessageDataRequest struct {
+	MessageId uuid.UUID `json:"messageId"`
+	Reason    string    `json:"reason"`
+}
+
+//	reportResolved response (to the reporting account)
+type WSReportResolvedDataResponse struct {
+	ReportId   uuid.UUID `json:"reportId"`
+	RoomId     uuid.UUID `json:"roomId"`
+	MessageId  uuid.UUID `json:"messageId"`
+	Resolution string    `json:"resolution"`
+	Comment    string    `json:"comment"`
+}
+
+//	messageExpired, messageDeleted response (to subscribers the message was visible to)
+type WSMessageExpiredDataResponse struct {
+	RoomId    uuid.UUID `json:"roomId"`
+	MessageId uuid.UUID `json:"messageId"`
+}
+
+//	messageHeld response (to the sender)
+type WSMessageHeldDataResponse struct {
+	RoomId          uuid.UUID `json:"roomId"`
+	HeldMessageId   uuid.UUID `json:"heldMessageId"`
+	ClientMessageId string    `json:"clientMessageId"`
+}
+
+//	pinsChanged response (to all the room's subscribers)
+type WSPinsChangedDataResponse struct {
+	RoomId uuid.UUID       `json:"roomId"`
+	Pins   []PinnedMessage `json:"pins"`
+}
+
+type WSSubscriberDataResponse struct {
+	RoomId  uuid.UUID `json:"roomId"`
+	Role    string    `json:"role"`
+	Account *Account  `json:"account"`
+}
+
+type WSRoomClosedDataResponse struct {
+	RoomId uuid.UUID `json:"roomId"`
+	Reason string    `json:"reason"`
+}
+
+//	accountMerged response (to the subscribers of the rooms of the merged account)
+type WSAccountMergedDataResponse struct {
+	FromAccountId uuid.UUID `json:"fromAccountId"`
+	ToAccountId   uuid.UUID `json:"toAccountId"`
+}
+
+//	roomTransferred response (to the both transferring accounts)
+type WSRoomTransferredDataResponse struct {
+	RoomId        uuid.UUID `json:"roomId"`
+	FromAccountId uuid.UUID `json:"fromAccountId"`
+	ToAccountId   uuid.UUID `json:"toAccountId"`
+	Role          string    `json:"role"`
+}
+
 //	anyMessageToClient from nats [response only]
 type WSMessageToMobileClientResponse struct {
 	Type string                              `json:"type"`
@@ -192,6 +337,39 @@ type WSSystemUserUnsubscribeRequestMessage struct {
 	Data RoomMessageAccountUnsubscribeRequest `json:"data"`
 }
 
+//	system account merged
+type WSSystemAccountMergedRequest struct {
+	WSSystemUserRequest
+	Message WSSystemAccountMergedRequestMessage `json:"message"`
+}
+
+type WSSystemAccountMergedRequestMessage struct {
+	Type string               `json:"type"`
+	Data AccountMergedMessage `json:"data"`
+}
+
+//	system account disconnect
+type WSSystemAccountDisconnectRequest struct {
+	WSSystemUserRequest
+	Message WSSystemAccountDisconnectRequestMessage `json:"message"`
+}
+
+type WSSystemAccountDisconnectRequestMessage struct {
+	Type string                   `json:"type"`
+	Data AccountDisconnectMessage `json:"data"`
+}
+
+//	system room closed
+type WSSystemRoomClosedRequest struct {
+	WSSystemUserRequest
+	Message WSSystemRoomClosedRequestMessage `json:"message"`
+}
+
+type WSSystemRoomClosedRequestMessage struct {
+	Type string            `json:"type"`
+	Data RoomClosedMessage `json:"data"`
+}
+
 //	other models
 
 type ExpandedAccountModel struct {
@@ -40,6 +40,20 @@ func (s *WebSocketService) accountConnect(w http.ResponseWriter, r *http.Request
 
 	w.Header().Set("Content-Type", "application/json")
 
+	// the limit is checked before the upgrade, so rejected clients get 429 with Retry-After
+	if wait, sysErr := s.ws.rateLimiter.checkConnection(); sysErr != nil {
+		w.Header().Set("Retry-After", retryAfterHeader(wait))
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write(createResponse(&WSChatErrorResponse{
+			Error: WSChatErrorErrorResponse{
+				Message:    sysErr.Message,
+				Code:       sysErr.Code,
+				RetryAfter: wait.Milliseconds(),
+			},
+		}))
+		return
+	}
+
 	//	upgrade websocket connection
 	conn, err := s.ws.httpServer.wsUpgrader.Upgrade(w, r, nil)
 	if err != nil {
@@ -65,10 +79,19 @@ func (s *WebSocketService) accountConnect(w http.ResponseWriter, r *http.Request
 	// get registered account by the ID (token) passed from the client
 	// currently we assume that account Id is passed
 	// if token comes we need to verify it with the external system
+	// anonymous accounts joined by the room's hash pass the token issued on joining
 	accRep := a.CreateRepository(app.GetDB())
-	account, sysErr := accRep.GetAccount(uuid.FromStringOrNil(token), "")
+	accountId, e := uuid.FromString(token)
+	if e != nil {
+		var tokenErr *system.Error
+		accountId, tokenErr = accRep.GetSessionTokenAccount(token)
+		if tokenErr != nil {
+			app.E().SetError(tokenErr)
+		}
+	}
+	account, sysErr := accRep.GetAccount(accountId, "")
 	app.L().Debugf("Account found by token: %s", *account)
-	if sysErr != nil || account.Id == uuid.Nil {
+	if sysErr != nil || account.Id == uuid.Nil || account.Status == AccountStatusMerged || account.Status == AccountStatusLocked {
 		response := &WSChatErrorResponse{
 			Error: WSChatErrorErrorResponse{
 				Message: system.WsUserIdentification,
@@ -111,7 +134,7 @@ func (s *WebSocketService) accountConnect(w http.ResponseWriter, r *http.Request
 
 	// resend recd messages to session socket
 	go func() {
-		for _, r := range rooms {
+		for _, r := range session.getRooms() {
 			s.ws.resendRecdMessagesToSession(session, r.roomId)
 		}
 	}()
@@ -2,3 +2,6 @@ package system
 
 const SystemMsgTypeUserSubscribe = "userSubscribe"
 const SystemMsgTypeUserUnsubscribe = "userUnsubscribe"
+const SystemMsgTypeAccountMerged = "accountMerged"
+const SystemMsgTypeRoomClosed = "roomClosed"
+const SystemMsgTypeAccountDisconnect = "accountDisconnect"
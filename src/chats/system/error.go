@@ -18,11 +18,68 @@ const (
 	AccountIncorrectOnlineStatus = 2004
 	AccountNotFoundById = 2005
 	AccountOnlineStatusWithoutLiveConnection = 2006
+	AccountMergeSameCode = 2007
+	AccountMergeNotAnonymousCode = 2008
+	AccountMergeTargetCode = 2009
 
 	NoRoomFoundByIdCode = 3001
 	NoRoomFoundByReferenceCode = 3002
 	RoomAlreadyClosedCode = 3003
 	NotSubscribedAccountCode = 3004
+	RoomTransferSameAccountCode = 3005
+	AccountAlreadySubscribedCode = 3006
+	RoomTypeNotSupportedCode = 3007
+	DirectRoomSubscribersCode = 3008
+	ObserverCannotSendCode = 3011
+	ObserverNotFoundCode = 3012
+	RoomHashNotFoundCode = 3013
+	RoomHashExpiredCode = 3014
+	RoomCloseAtInvalidCode = 3015
+	RoomNotClosedCode = 3016
+	RoomReopenPeriodExpiredCode = 3017
+	RoomReopenSubscribersCode = 3018
+	RoomArchivedCode = 3019
+	RoomAttributesInvalidCode = 3020
+	RoomsCursorInvalidCode = 3021
+	InitiatorRequiredCode = 3022
+
+	MessageTypeNotSupportedCode = 3101
+	MessagePayloadInvalidCode = 3102
+	MessagePayloadNotAllowedCode = 3103
+	MessageNotFoundCode = 3104
+	MessageButtonNotFoundCode = 3105
+	MessageVisibilityInvalidCode = 3106
+	MessageVisibilityRoleCode = 3107
+	RoomPinsLimitCode = 3108
+	MessagePinNotAllowedCode = 3109
+	MessageForwardLimitCode = 3110
+	ScheduledMessageNotFoundCode = 3111
+	ScheduledMessageRoomClosedCode = 3112
+	MessageExpiredCode = 3113
+	MessageProfanityCode = 3114
+	MessageLinksNotAllowedCode = 3115
+	MessageContactsNotAllowedCode = 3116
+	MessageRejectedCode = 3117
+	ModerationHookErrorCode = 3118
+	HeldMessageNotFoundCode = 3119
+	ModerationFilterInvalidCode = 3120
+	MessageReportOwnCode = 3121
+	MessageAlreadyReportedCode = 3122
+	MessageReportsNotFoundCode = 3123
+	MessageReportResolutionInvalidCode = 3124
+	MessageForwardNotAllowedCode = 3125
+
+	QueueNotSpecifiedCode = 3201
+	AccountNotActiveCode = 3202
+
+	RoleNotFoundCode = 3301
+	CapabilityDeniedCode = 3302
+	CapabilityNotSupportedCode = 3303
+
+	RateLimitAccountCode = 3401
+	RateLimitRoomCode = 3402
+	RateLimitConnectionCode = 3403
+	RateLimitErrorCode = 3404
 
 	IncorrectRequestCode = 4000
 
@@ -67,11 +124,68 @@ var errList = Errors {
 	AccountIncorrectOnlineStatus: "Некорректный онлайн статус",
 	AccountNotFoundById: "Аккаунт не найден по ИД %s",
 	AccountOnlineStatusWithoutLiveConnection: "невозможно установить статус %s при отсутствие открытого соединения",
+	AccountMergeSameCode: "Невозможно объединить аккаунт с самим собой",
+	AccountMergeNotAnonymousCode: "Аккаунт %s не является анонимным",
+	AccountMergeTargetCode: "Аккаунт %s не может быть объединен с анонимным аккаунтом",
 
 	NoRoomFoundByIdCode: "Комната не найдена по ИД %s",
 	NoRoomFoundByReferenceCode: "Комната не найдена по referenceId %s",
 	RoomAlreadyClosedCode: "Комната уже закрыта",
 	NotSubscribedAccountCode: "Аккаунт %s не подписан на комнату %s",
+	RoomTransferSameAccountCode: "Невозможно передать комнату тому же аккаунту",
+	AccountAlreadySubscribedCode: "Аккаунт %s уже подписан на комнату %s",
+	RoomTypeNotSupportedCode: "Тип комнаты %s не поддерживается",
+	DirectRoomSubscribersCode: "Комната типа direct должна содержать ровно двух подписчиков",
+	ObserverCannotSendCode: "Наблюдатель %s не может отправлять сообщения в комнату %s",
+	ObserverNotFoundCode: "Аккаунт %s не является наблюдателем комнаты %s",
+	RoomHashNotFoundCode: "Комната не найдена по ссылке",
+	RoomHashExpiredCode: "Срок действия ссылки на комнату истек",
+	RoomCloseAtInvalidCode: "Время закрытия комнаты должно быть в будущем",
+	RoomNotClosedCode: "Комната %s не закрыта",
+	RoomReopenPeriodExpiredCode: "Комната %s закрыта слишком давно и не может быть открыта повторно",
+	RoomReopenSubscribersCode: "В комнате %s нет активных подписчиков",
+	RoomArchivedCode: "Комната %s находится в архиве",
+	RoomAttributesInvalidCode: "Атрибуты комнаты должны быть JSON-объектом",
+	RoomsCursorInvalidCode: "Некорректный курсор списка комнат",
+	InitiatorRequiredCode: "Для комнаты типа %s требуется указать инициатора запроса",
+
+	MessageTypeNotSupportedCode: "Тип сообщения %s не поддерживается",
+	MessagePayloadInvalidCode: "Некорректное содержимое сообщения типа %s: %s",
+	MessagePayloadNotAllowedCode: "Сообщение типа %s не может содержать структурированных данных",
+	MessageNotFoundCode: "Сообщение не найдено по ИД %s",
+	MessageButtonNotFoundCode: "Кнопка %s не найдена в сообщении %s",
+	MessageVisibilityInvalidCode: "Некорректная область видимости сообщения %s",
+	MessageVisibilityRoleCode: "Роль отправителя %s должна входить в список ролей, которым видно сообщение",
+	RoomPinsLimitCode: "В комнате %s закреплено максимальное количество сообщений (%d)",
+	MessagePinNotAllowedCode: "Сообщение %s видно не всем подписчикам и не может быть закреплено",
+	MessageForwardLimitCode: "За один раз можно переслать не более %d сообщений",
+	ScheduledMessageNotFoundCode: "Отложенное сообщение %s не найдено",
+	ScheduledMessageRoomClosedCode: "Комната %s закрыта, отложенное сообщение не отправлено",
+	MessageExpiredCode: "Срок жизни сообщения %s истек",
+	MessageProfanityCode: "Сообщение содержит недопустимые слова",
+	MessageLinksNotAllowedCode: "Сообщение содержит запрещенные ссылки",
+	MessageContactsNotAllowedCode: "Сообщение содержит контактные данные",
+	MessageRejectedCode: "Сообщение отклонено модерацией: %s",
+	ModerationHookErrorCode: "Ошибка сервиса модерации: %s",
+	HeldMessageNotFoundCode: "Сообщение на модерации %s не найдено",
+	ModerationFilterInvalidCode: "Некорректная настройка фильтра модерации %s: %s",
+	MessageReportOwnCode: "Нельзя пожаловаться на собственное сообщение",
+	MessageAlreadyReportedCode: "Жалоба на сообщение %s уже отправлена",
+	MessageReportsNotFoundCode: "Открытые жалобы на сообщение %s не найдены",
+	MessageReportResolutionInvalidCode: "Некорректное решение по жалобе: %s",
+	MessageForwardNotAllowedCode: "Сообщение %s видно не всем подписчикам и не может быть переслано",
+
+	QueueNotSpecifiedCode: "Не указана очередь",
+	AccountNotActiveCode: "Аккаунт %s не активен",
+
+	RoleNotFoundCode: "Роль %s не найдена",
+	CapabilityDeniedCode: "У аккаунта %s нет права %s в комнате %s",
+	CapabilityNotSupportedCode: "Право %s не поддерживается",
+
+	RateLimitAccountCode: "Слишком много сообщений, повторите через %d мс",
+	RateLimitRoomCode: "Слишком много сообщений в комнате %s, повторите через %d мс",
+	RateLimitConnectionCode: "Слишком много подключений, повторите через %d мс",
+	RateLimitErrorCode: "Ошибка проверки ограничения частоты запросов: %s",
 
 	IncorrectRequestCode: "Некорректный запрос",
 
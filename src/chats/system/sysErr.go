@@ -28,7 +28,7 @@ func SysErr(err error, id int, data []byte) *Error {
 func SysErrf(err error, id int, data []byte, a...interface{}) *Error {
 	return &Error{
 		Error:   err,
-		Message: GetErrorf(id, a),
+		Message: GetErrorf(id, a...),
 		Code:    id,
 		Data:    data,
 	}
@@ -42,7 +42,7 @@ func GetError(code int) string {
 }
 
 func GetErrorf(code int, a...interface{}) string {
-	return fmt.Sprintf(GetError(code) + "\n", a)
+	return fmt.Sprintf(GetError(code) + "\n", a...)
 }
 
 func MarshalError1011(err error, params []byte) *Error {
@@ -1,8 +1,15 @@
 package tests
 
 import (
+	"archive/zip"
+	"bytes"
 	pb "chats/proto"
+	"chats/server"
+	"chats/system"
 	"chats/tests/helper"
+	"context"
+	"io/ioutil"
+	"strings"
 	"testing"
 	"time"
 )
@@ -184,3 +191,198 @@ func TestOnlineStatusWhenWSConnectingAndDisconnecting_Success(t *testing.T) {
 
 }
 
+func TestMergeAnonymousAccount_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	anonymousId, err := helper.CreateAnonymousAccount(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	accountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	roomRs, err := pb.NewRoomClient(conn).Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Subscribers: []*pb.SubscriberRequest{
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(anonymousId)},
+				Role:    "client",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roomRs.Errors) > 0 {
+		t.Fatal(roomRs.Errors[0].Message)
+	}
+
+	mergeRs, err := pb.NewAccountClient(conn).Merge(ctx, &pb.MergeAccountsRequest{
+		FromAccount: &pb.AccountIdRequest{AccountId: pb.FromUUID(anonymousId)},
+		ToAccount:   &pb.AccountIdRequest{AccountId: pb.FromUUID(accountId)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mergeRs.Errors) > 0 {
+		t.Fatal(mergeRs.Errors[0].Message)
+	}
+
+	rooms, err := pb.NewRoomClient(conn).GetByCriteria(ctx, &pb.GetRoomsByCriteriaRequest{
+		AccountId:       &pb.AccountIdRequest{AccountId: pb.FromUUID(accountId)},
+		WithSubscribers: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, room := range rooms.Rooms {
+		if room.Id.Value == roomRs.Result.Id.Value {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("Room of the anonymous account must be moved to the registered account")
+	}
+
+}
+
+func TestExportAndEraseAccountData_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	accountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operatorId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	roomService := pb.NewRoomClient(conn)
+	accountService := pb.NewAccountClient(conn)
+
+	roomRs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Subscribers: []*pb.SubscriberRequest{
+			{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(accountId)}, Role: "client"},
+			{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(operatorId)}, Role: "operator"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roomRs.Errors) > 0 {
+		t.Fatal(roomRs.Errors[0].Message)
+	}
+
+	sendRs, err := roomService.SendChatMessages(ctx, &pb.SendChatMessagesRequest{
+		SenderAccountId: pb.FromUUID(accountId),
+		Type:            server.EventMessage,
+		Data: &pb.SendChatMessagesDataRequest{Messages: []*pb.SendChatMessageDataRequest{
+			{RoomId: roomRs.Result.Id, Type: "message", Text: "мой адрес: Москва"},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sendRs.Errors) > 0 {
+		t.Fatal(sendRs.Errors[0].Message)
+	}
+
+	exportRs, err := accountService.ExportData(ctx, &pb.AccountDataRequest{
+		AccountId:          &pb.AccountIdRequest{AccountId: pb.FromUUID(accountId)},
+		InitiatorAccountId: pb.FromUUID(operatorId),
+		Reason:             "запрос субъекта данных",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exportRs.Errors) > 0 {
+		t.Fatal(exportRs.Errors[0].Message)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(exportRs.Data), int64(len(exportRs.Data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := make(map[string]string)
+	for _, f := range archive.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[f.Name] = string(content)
+	}
+
+	for _, name := range []string{"profile.json", "rooms.json", "messages.json", "statuses.json"} {
+		if _, ok := files[name]; !ok {
+			t.Fatalf("Export must contain %s", name)
+		}
+	}
+	if !strings.Contains(files["messages.json"], "мой адрес: Москва") {
+		t.Fatal("Export must contain sent messages")
+	}
+	if !strings.Contains(files["rooms.json"], roomRs.Result.Id.Value) {
+		t.Fatal("Export must contain room memberships")
+	}
+
+	eraseRs, err := accountService.EraseData(ctx, &pb.AccountDataRequest{
+		AccountId:          &pb.AccountIdRequest{AccountId: pb.FromUUID(accountId)},
+		InitiatorAccountId: pb.FromUUID(operatorId),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(eraseRs.Errors) > 0 {
+		t.Fatal(eraseRs.Errors[0].Message)
+	}
+
+	items, err := helper.GetAccountsByCriteria(conn, &pb.GetAccountsByCriteriaRequest{
+		AccountId: &pb.AccountIdRequest{AccountId: pb.FromUUID(accountId)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) == 0 || items[0].FirstName != "" || items[0].Email != "" || items[0].Phone != "" || items[0].Account != accountId.String() {
+		t.Fatal("Personal data must be erased")
+	}
+
+	history, err := helper.GetMessageHistory(roomRs.Result.Id.ToUUID(), operatorId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history.Messages) != 1 || history.Messages[0].Message != server.ErasedMessageText {
+		t.Fatal("Message text must be replaced with the placeholder")
+	}
+
+}
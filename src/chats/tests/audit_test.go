@@ -0,0 +1,98 @@
+package tests
+
+import (
+	pb "chats/proto"
+	"chats/server"
+	"chats/system"
+	"chats/tests/helper"
+	"context"
+	"testing"
+)
+
+func TestAuditLog_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	accountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	roomService := pb.NewRoomClient(conn)
+	auditService := pb.NewAuditClient(conn)
+
+	roomRs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Subscribers: []*pb.SubscriberRequest{
+			{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(accountId)}, Role: "client"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roomRs.Errors) > 0 {
+		t.Fatal(roomRs.Errors[0].Message)
+	}
+
+	closeRs, err := roomService.CloseRoom(ctx, &pb.CloseRoomRequest{RoomId: roomRs.Result.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(closeRs.Errors) > 0 {
+		t.Fatal(closeRs.Errors[0].Message)
+	}
+
+	auditRs, err := auditService.GetAuditLog(ctx, &pb.GetAuditLogRequest{
+		TargetType: server.AuditTargetRoom,
+		TargetId:   roomRs.Result.Id,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(auditRs.Errors) > 0 {
+		t.Fatal(auditRs.Errors[0].Message)
+	}
+
+	actions := make(map[string]bool)
+	for _, item := range auditRs.Items {
+		actions[item.Action] = true
+	}
+	for _, action := range []string{server.AuditActionRoomCreate, server.AuditActionRoomClose} {
+		if !actions[action] {
+			t.Fatalf("Audit log must contain %s", action)
+		}
+	}
+
+	lockRs, err := pb.NewAccountClient(conn).Lock(ctx, &pb.LockAccountRequest{
+		AccountId: &pb.AccountIdRequest{AccountId: pb.FromUUID(accountId)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lockRs.Errors) > 0 {
+		t.Fatal(lockRs.Errors[0].Message)
+	}
+
+	auditRs, err = auditService.GetAuditLog(ctx, &pb.GetAuditLogRequest{
+		Action:   server.AuditActionAccountLock,
+		TargetId: pb.FromUUID(accountId),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(auditRs.Errors) > 0 {
+		t.Fatal(auditRs.Errors[0].Message)
+	}
+	if len(auditRs.Items) != 1 {
+		t.Fatal("Locking the account must be audited")
+	}
+
+}
@@ -6,6 +6,8 @@ import (
 	"chats/system"
 	"chats/tests/helper"
 	"context"
+	"encoding/json"
+	uuid "github.com/satori/go.uuid"
 	"log"
 	"testing"
 	"time"
@@ -540,3 +542,921 @@ func TestResendMessages_Success(t *testing.T) {
 
 }
 
+
+func TestButtonsMessageClick_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	accountIdFirst, _, err := helper.CreateDefaultAccount(conn)
+	accountIdSecond, _, err := helper.CreateDefaultAccount(conn)
+
+	wsFirst, msgChanFirst, err := helper.AccountWebSocket(accountIdFirst)
+	wsSecond, msgChanSecond, err := helper.AccountWebSocket(accountIdSecond)
+
+	defer close(msgChanFirst)
+	defer close(msgChanSecond)
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Subscribers: []*pb.SubscriberRequest{
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(accountIdFirst)},
+				Role:    "operator",
+			},
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(accountIdSecond)},
+				Role:    "client",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	roomId := r.Result.Id.ToUUID()
+
+	err = helper.SendMessage(wsFirst, accountIdFirst, server.EventMessage, &server.WSChatMessageDataRequest{
+		RoomId:  roomId,
+		Type:    server.MessageTypeButtons,
+		Text:    "выберите вариант",
+		Payload: []byte(`{"buttons": [{"id": "yes", "title": "Да", "value": "1"}, {"id": "no", "title": "Нет"}]}`),
+	})
+	if err != nil {
+		t.Fatal("Failed")
+	}
+
+	// the second account receives the message and clicks the button
+	for {
+		select {
+		case msg := <-msgChanSecond:
+			message := &helper.WSChatResponse{}
+			_ = json.Unmarshal(msg, message)
+			if message.Type != server.EventMessage || len(message.Data.Messages) == 0 {
+				continue
+			}
+			m := message.Data.Messages[0]
+			if len(m.Payload) == 0 {
+				t.Fatal("Test failed. Payload is empty")
+			}
+			err = helper.SendButtonClick(wsSecond, roomId, m.Id, "yes")
+			if err != nil {
+				t.Fatal("Failed")
+			}
+		case msg := <-msgChanFirst:
+			response := &struct {
+				Type string                               `json:"type"`
+				Data server.WSChatButtonClickDataResponse `json:"data"`
+			}{}
+			_ = json.Unmarshal(msg, response)
+			if response.Type != server.EventButtonClick {
+				continue
+			}
+			if response.Data.AccountId != accountIdSecond || response.Data.ButtonId != "yes" || response.Data.Value != "1" {
+				t.Fatal("Test failed. Incorrect button click")
+			}
+			return
+		case <-time.After(10 * time.Second):
+			t.Fatal("Test failed. Timeout")
+		}
+	}
+
+}
+
+func TestPinAndStarMessage_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	clientId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	operatorId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Subscribers: []*pb.SubscriberRequest{
+			{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(clientId)}, Role: "client"},
+			{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(operatorId)}, Role: "operator"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rs.Errors) > 0 {
+		t.Fatal(rs.Errors[0].Message)
+	}
+	roomId := rs.Result.Id.ToUUID()
+
+	sendRs, err := roomService.SendChatMessages(ctx, &pb.SendChatMessagesRequest{
+		SenderAccountId: pb.FromUUID(operatorId),
+		Type:            server.EventMessage,
+		Data: &pb.SendChatMessagesDataRequest{Messages: []*pb.SendChatMessageDataRequest{
+			{RoomId: pb.FromUUID(roomId), Type: "message", Text: "инструкция"},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(sendRs.Errors) > 0 {
+		t.Fatal(sendRs.Errors[0].Message)
+	}
+
+	history, err := helper.GetMessageHistory(roomId, clientId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(history.Messages) != 1 {
+		t.Fatal("Message must be found")
+	}
+	messageId := history.Messages[0].Id
+
+	// clients aren't allowed to pin messages
+	pinRs, err := roomService.PinMessage(ctx, &pb.PinMessageRequest{
+		RoomId:             pb.FromUUID(roomId),
+		MessageId:          pb.FromUUID(messageId),
+		InitiatorAccountId: pb.FromUUID(clientId),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(pinRs.Errors) == 0 {
+		t.Fatal("Client must not pin messages")
+	}
+
+	pinRs, err = roomService.PinMessage(ctx, &pb.PinMessageRequest{
+		RoomId:             pb.FromUUID(roomId),
+		MessageId:          pb.FromUUID(messageId),
+		InitiatorAccountId: pb.FromUUID(operatorId),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(pinRs.Errors) > 0 {
+		t.Fatal(pinRs.Errors[0].Message)
+	}
+
+	rooms, err := roomService.GetByCriteria(ctx, &pb.GetRoomsByCriteriaRequest{RoomId: pb.FromUUID(roomId), WithClosed: true})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rooms.Rooms) != 1 || len(rooms.Rooms[0].Pins) != 1 || rooms.Rooms[0].Pins[0].MessageId.ToUUID() != messageId {
+		t.Fatal("Pinned message must be returned with the room")
+	}
+
+	starRs, err := roomService.StarMessage(ctx, &pb.StarMessageRequest{
+		AccountId: pb.FromUUID(clientId),
+		MessageId: pb.FromUUID(messageId),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(starRs.Errors) > 0 {
+		t.Fatal(starRs.Errors[0].Message)
+	}
+
+	starred, err := helper.GetStarredMessages(clientId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(starred.Messages) != 1 || starred.Messages[0].Id != messageId {
+		t.Fatal("Starred message must be found")
+	}
+
+	starred, err = helper.GetStarredMessages(operatorId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(starred.Messages) != 0 {
+		t.Fatal("Stars are personal")
+	}
+
+}
+
+func TestForwardMessages_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	clientId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	operatorId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	memberId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	createRoom := func(subscribers ...*pb.SubscriberRequest) uuid.UUID {
+		rs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+			ReferenceId: system.Uuid().String(),
+			Chat:        true,
+			Subscribers: subscribers,
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(rs.Errors) > 0 {
+			t.Fatal(rs.Errors[0].Message)
+		}
+		return rs.Result.Id.ToUUID()
+	}
+
+	sourceRoomId := createRoom(
+		&pb.SubscriberRequest{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(clientId)}, Role: "client"},
+		&pb.SubscriberRequest{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(operatorId)}, Role: "operator"},
+	)
+	targetRoomId := createRoom(
+		&pb.SubscriberRequest{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(operatorId)}, Role: "operator"},
+		&pb.SubscriberRequest{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(memberId)}, Role: "member"},
+	)
+
+	sendRs, err := roomService.SendChatMessages(ctx, &pb.SendChatMessagesRequest{
+		SenderAccountId: pb.FromUUID(clientId),
+		Type:            server.EventMessage,
+		Data: &pb.SendChatMessagesDataRequest{Messages: []*pb.SendChatMessageDataRequest{
+			{RoomId: pb.FromUUID(sourceRoomId), Type: "message", Text: "проблема с заказом"},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(sendRs.Errors) > 0 {
+		t.Fatal(sendRs.Errors[0].Message)
+	}
+
+	history, err := helper.GetMessageHistory(sourceRoomId, operatorId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(history.Messages) != 1 {
+		t.Fatal("Message must be found")
+	}
+	original := history.Messages[0]
+
+	// the client isn't subscribed to the target room
+	forwardRs, err := roomService.ForwardMessages(ctx, &pb.ForwardMessagesRequest{
+		AccountId:    pb.FromUUID(clientId),
+		SourceRoomId: pb.FromUUID(sourceRoomId),
+		TargetRoomId: pb.FromUUID(targetRoomId),
+		MessageIds:   []*pb.UUID{pb.FromUUID(original.Id)},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(forwardRs.Errors) == 0 {
+		t.Fatal("Forwarding to the room the account isn't subscribed to must fail")
+	}
+
+	forwardRs, err = roomService.ForwardMessages(ctx, &pb.ForwardMessagesRequest{
+		AccountId:    pb.FromUUID(operatorId),
+		SourceRoomId: pb.FromUUID(sourceRoomId),
+		TargetRoomId: pb.FromUUID(targetRoomId),
+		MessageIds:   []*pb.UUID{pb.FromUUID(original.Id)},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(forwardRs.Errors) > 0 {
+		t.Fatal(forwardRs.Errors[0].Message)
+	}
+
+	history, err = helper.GetMessageHistory(targetRoomId, memberId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(history.Messages) != 1 {
+		t.Fatal("Forwarded message must be found in the target room")
+	}
+
+	forwarded := history.Messages[0]
+	if forwarded.Message != original.Message || forwarded.SenderAccountId != operatorId {
+		t.Fatal("Forwarded message must be sent by the forwarding account")
+	}
+	if forwarded.ForwardedFrom == nil ||
+		forwarded.ForwardedFrom.MessageId != original.Id ||
+		forwarded.ForwardedFrom.RoomId != sourceRoomId ||
+		forwarded.ForwardedFrom.AccountId != clientId {
+		t.Fatal("Forwarded message must refer to the original one")
+	}
+
+}
+
+func TestScheduleAndCancelMessage_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	clientId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	operatorId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Subscribers: []*pb.SubscriberRequest{
+			{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(clientId)}, Role: "client"},
+			{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(operatorId)}, Role: "operator"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rs.Errors) > 0 {
+		t.Fatal(rs.Errors[0].Message)
+	}
+	roomId := rs.Result.Id
+
+	sendRs, err := roomService.SendChatMessages(ctx, &pb.SendChatMessagesRequest{
+		SenderAccountId: pb.FromUUID(operatorId),
+		Type:            server.EventMessage,
+		Data: &pb.SendChatMessagesDataRequest{Messages: []*pb.SendChatMessageDataRequest{
+			{
+				RoomId: roomId,
+				Type:   "message",
+				Text:   "как у вас дела?",
+				SendAt: &pb.Timestamp{Value: time.Now().Add(2 * time.Hour).Format(time.RFC3339)},
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(sendRs.Errors) > 0 {
+		t.Fatal(sendRs.Errors[0].Message)
+	}
+	if len(sendRs.ScheduledMessageIds) != 1 {
+		t.Fatal("Message must be scheduled")
+	}
+
+	history, err := helper.GetMessageHistory(roomId.ToUUID(), clientId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(history.Messages) != 0 {
+		t.Fatal("Scheduled message must not be sent right away")
+	}
+
+	scheduled, err := roomService.GetScheduledMessages(ctx, &pb.GetScheduledMessagesRequest{RoomId: roomId})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(scheduled.Messages) != 1 || scheduled.Messages[0].Message.Text != "как у вас дела?" {
+		t.Fatal("Scheduled message must be listed")
+	}
+
+	// the client can't cancel the operator's message
+	cancelRs, err := roomService.CancelScheduledMessage(ctx, &pb.CancelScheduledMessageRequest{
+		Id:                 sendRs.ScheduledMessageIds[0],
+		InitiatorAccountId: pb.FromUUID(clientId),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(cancelRs.Errors) == 0 {
+		t.Fatal("Client must not cancel others' messages")
+	}
+
+	cancelRs, err = roomService.CancelScheduledMessage(ctx, &pb.CancelScheduledMessageRequest{
+		Id:                 sendRs.ScheduledMessageIds[0],
+		InitiatorAccountId: pb.FromUUID(operatorId),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(cancelRs.Errors) > 0 {
+		t.Fatal(cancelRs.Errors[0].Message)
+	}
+
+	scheduled, err = roomService.GetScheduledMessages(ctx, &pb.GetScheduledMessagesRequest{RoomId: roomId})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(scheduled.Messages) != 0 {
+		t.Fatal("Cancelled message must not be listed")
+	}
+
+}
+
+func TestMessageExpiry_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	clientId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	operatorId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		MessageTtl:  3600,
+		Subscribers: []*pb.SubscriberRequest{
+			{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(clientId)}, Role: "client"},
+			{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(operatorId)}, Role: "operator"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rs.Errors) > 0 {
+		t.Fatal(rs.Errors[0].Message)
+	}
+	roomId := rs.Result.Id
+
+	sendRs, err := roomService.SendChatMessages(ctx, &pb.SendChatMessagesRequest{
+		SenderAccountId: pb.FromUUID(operatorId),
+		Type:            server.EventMessage,
+		Data: &pb.SendChatMessagesDataRequest{Messages: []*pb.SendChatMessageDataRequest{
+			{
+				RoomId: roomId,
+				Type:   "message",
+				Text:   "код подтверждения 1234",
+				Ttl:    1,
+			},
+			{
+				RoomId: roomId,
+				Type:   "message",
+				Text:   "как у вас дела?",
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(sendRs.Errors) > 0 {
+		t.Fatal(sendRs.Errors[0].Message)
+	}
+
+	// the message is expected to be wiped out by the cron node
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+
+		history, err := helper.GetMessageHistory(roomId.ToUUID(), clientId)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(history.Messages) != 2 {
+			t.Fatal("Expired message must be kept in the history")
+		}
+
+		var expired, actual *server.MessageHistoryItem
+		for i := range history.Messages {
+			if history.Messages[i].Message == "как у вас дела?" {
+				actual = &history.Messages[i]
+			} else {
+				expired = &history.Messages[i]
+			}
+		}
+
+		if actual == nil || actual.Expired || actual.ExpiresAt == nil {
+			t.Fatal("Message must expire by the room's ttl")
+		}
+
+		if expired.Expired {
+			if expired.Message != "" {
+				t.Fatal("Expired message's text must be wiped out")
+			}
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatal("Message hasn't expired")
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func TestHeldMessages_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	clientId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Subscribers: []*pb.SubscriberRequest{
+			{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(clientId)}, Role: "client"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rs.Errors) > 0 {
+		t.Fatal(rs.Errors[0].Message)
+	}
+	roomId := rs.Result.Id
+
+	// a clean text passes any moderation chain
+	sendRs, err := roomService.SendChatMessages(ctx, &pb.SendChatMessagesRequest{
+		SenderAccountId: pb.FromUUID(clientId),
+		Type:            server.EventMessage,
+		Data: &pb.SendChatMessagesDataRequest{Messages: []*pb.SendChatMessageDataRequest{
+			{RoomId: roomId, Type: "message", Text: "добрый день"},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(sendRs.Errors) > 0 {
+		t.Fatal(sendRs.Errors[0].Message)
+	}
+	if len(sendRs.HeldMessageIds) > 0 {
+		t.Fatal("Clean message must not be held")
+	}
+
+	heldRs, err := roomService.GetHeldMessages(ctx, &pb.GetHeldMessagesRequest{RoomId: roomId})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(heldRs.Errors) > 0 {
+		t.Fatal(heldRs.Errors[0].Message)
+	}
+	if len(heldRs.Messages) > 0 {
+		t.Fatal("No messages must be held in the room")
+	}
+
+	reviewRs, err := roomService.ReviewHeldMessage(ctx, &pb.ReviewHeldMessageRequest{
+		Id:      pb.FromUUID(system.Uuid()),
+		Approve: true,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(reviewRs.Errors) == 0 || reviewRs.Errors[0].Code != system.HeldMessageNotFoundCode {
+		t.Fatal("Review of unknown message must fail")
+	}
+
+}
+
+func TestMessageReports_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	clientId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	operatorId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Subscribers: []*pb.SubscriberRequest{
+			{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(clientId)}, Role: "client"},
+			{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(operatorId)}, Role: "operator"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rs.Errors) > 0 {
+		t.Fatal(rs.Errors[0].Message)
+	}
+	roomId := rs.Result.Id.ToUUID()
+
+	sendRs, err := roomService.SendChatMessages(ctx, &pb.SendChatMessagesRequest{
+		SenderAccountId: pb.FromUUID(clientId),
+		Type:            server.EventMessage,
+		Data: &pb.SendChatMessagesDataRequest{Messages: []*pb.SendChatMessageDataRequest{
+			{RoomId: pb.FromUUID(roomId), Type: "message", Text: "грубость"},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(sendRs.Errors) > 0 {
+		t.Fatal(sendRs.Errors[0].Message)
+	}
+
+	history, err := helper.GetMessageHistory(roomId, clientId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(history.Messages) != 1 {
+		t.Fatal("Message must be found")
+	}
+	messageId := history.Messages[0].Id
+
+	reportRs, err := roomService.ReportMessage(ctx, &pb.ReportMessageRequest{
+		AccountId: pb.FromUUID(clientId),
+		MessageId: pb.FromUUID(messageId),
+		Reason:    "спам",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(reportRs.Errors) == 0 || reportRs.Errors[0].Code != system.MessageReportOwnCode {
+		t.Fatal("Own message must not be reported")
+	}
+
+	reportRs, err = roomService.ReportMessage(ctx, &pb.ReportMessageRequest{
+		AccountId: pb.FromUUID(operatorId),
+		MessageId: pb.FromUUID(messageId),
+		Reason:    "оскорбление",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(reportRs.Errors) > 0 {
+		t.Fatal(reportRs.Errors[0].Message)
+	}
+
+	reportRs, err = roomService.ReportMessage(ctx, &pb.ReportMessageRequest{
+		AccountId: pb.FromUUID(operatorId),
+		MessageId: pb.FromUUID(messageId),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(reportRs.Errors) == 0 || reportRs.Errors[0].Code != system.MessageAlreadyReportedCode {
+		t.Fatal("Message must not be reported twice")
+	}
+
+	queueRs, err := roomService.GetMessageReports(ctx, &pb.GetMessageReportsRequest{RoomId: pb.FromUUID(roomId)})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(queueRs.Errors) > 0 {
+		t.Fatal(queueRs.Errors[0].Message)
+	}
+	if len(queueRs.Messages) != 1 || queueRs.Messages[0].Message.Id.ToUUID() != messageId || len(queueRs.Messages[0].Reports) != 1 {
+		t.Fatal("Reported message must be in the queue")
+	}
+
+	// operators aren't allowed to delete messages
+	resolveRs, err := roomService.ResolveMessageReports(ctx, &pb.ResolveMessageReportsRequest{
+		MessageId:          pb.FromUUID(messageId),
+		Resolution:         server.MessageReportDelete,
+		InitiatorAccountId: pb.FromUUID(operatorId),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resolveRs.Errors) == 0 {
+		t.Fatal("Operator must not resolve reports")
+	}
+
+	resolveRs, err = roomService.ResolveMessageReports(ctx, &pb.ResolveMessageReportsRequest{
+		MessageId:  pb.FromUUID(messageId),
+		Resolution: server.MessageReportDelete,
+		Comment:    "сообщение удалено",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resolveRs.Errors) > 0 {
+		t.Fatal(resolveRs.Errors[0].Message)
+	}
+
+	history, err = helper.GetMessageHistory(roomId, clientId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(history.Messages) != 1 || !history.Messages[0].Expired || history.Messages[0].Message != "" {
+		t.Fatal("Message content must be deleted")
+	}
+
+	queueRs, err = roomService.GetMessageReports(ctx, &pb.GetMessageReportsRequest{RoomId: pb.FromUUID(roomId)})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(queueRs.Messages) != 0 {
+		t.Fatal("Resolved reports must leave the queue")
+	}
+
+}
+
+func TestAccountRateLimit_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	accountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	ws, msgChan, err := helper.AccountWebSocket(accountId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer ws.Close()
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Subscribers: []*pb.SubscriberRequest{
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(accountId)},
+				Role:    "client",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	roomId := r.Result.Id.ToUUID()
+
+	// the default account burst is exceeded (the room's burst is not)
+	for i := 0; i < 50; i++ {
+		err = helper.SendMessage(ws, accountId, server.EventMessage, &server.WSChatMessageDataRequest{
+			RoomId: roomId,
+			Type:   server.MessageTypeMessage,
+			Text:   "привет",
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	for {
+		select {
+		case msg := <-msgChan:
+			response := &struct {
+				Type string                     `json:"type"`
+				Data server.WSErrorDataResponse `json:"data"`
+			}{}
+			_ = json.Unmarshal(msg, response)
+			if response.Type == server.EventError && response.Data.Code == system.RateLimitAccountCode {
+				if response.Data.RetryAfter <= 0 {
+					t.Fatal("Retry-after must be specified")
+				}
+				return
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatal("Messages over the limit must be rejected")
+		}
+	}
+
+}
+
+func TestFormerSubscriberSendMessage_Fail(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	clientId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	operatorId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Subscribers: []*pb.SubscriberRequest{
+			{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(clientId)}, Role: "client"},
+			{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(operatorId)}, Role: "operator"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rs.Errors) > 0 {
+		t.Fatal(rs.Errors[0].Message)
+	}
+	roomId := rs.Result.Id
+
+	send := func(accountId uuid.UUID) *pb.SendChatMessageResponse {
+		sendRs, err := roomService.SendChatMessages(ctx, &pb.SendChatMessagesRequest{
+			SenderAccountId: pb.FromUUID(accountId),
+			Type:            server.EventMessage,
+			Data: &pb.SendChatMessagesDataRequest{Messages: []*pb.SendChatMessageDataRequest{
+				{RoomId: roomId, Type: "message", Text: "привет"},
+			}},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return sendRs
+	}
+
+	// the room gets cached with both subscribers
+	if sendRs := send(operatorId); len(sendRs.Errors) > 0 {
+		t.Fatal(sendRs.Errors[0].Message)
+	}
+
+	unsubscribeRs, err := roomService.Unsubscribe(ctx, &pb.RoomUnsubscribeRequest{
+		RoomId:    roomId,
+		AccountId: &pb.AccountIdRequest{AccountId: pb.FromUUID(operatorId)},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(unsubscribeRs.Errors) > 0 {
+		t.Fatal(unsubscribeRs.Errors[0].Message)
+	}
+
+	if sendRs := send(operatorId); len(sendRs.Errors) == 0 || sendRs.Errors[0].Code != system.MysqlChatAccessDeniedCode {
+		t.Fatal("Former subscriber mustn't be able to send messages")
+	}
+
+	if sendRs := send(clientId); len(sendRs.Errors) > 0 {
+		t.Fatal(sendRs.Errors[0].Message)
+	}
+
+}
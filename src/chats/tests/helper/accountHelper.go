@@ -68,6 +68,27 @@ func CreateBotAccount(conn *grpc.ClientConn) (id uuid.UUID, externalId string, e
 
 }
 
+func CreateAnonymousAccount(conn *grpc.ClientConn) (id uuid.UUID, err error) {
+
+	accountService := pb.NewAccountClient(conn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rs, err := accountService.Create(ctx, &pb.CreatAccountRequest{
+		Account: "anonymous",
+		Type:    "anonymous_user",
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	log.Printf("Anonymous account created. id: %s \n", rs.Account.Id.Value)
+
+	return rs.Account.Id.ToUUID(), nil
+
+}
+
 
 func UpdateAccount(conn *grpc.ClientConn, rq *pb.UpdateAccountRequest) error {
 
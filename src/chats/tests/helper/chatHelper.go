@@ -69,6 +69,29 @@ func SendReadStatus(socket *websocket.Conn, roomId uuid.UUID, messageId uuid.UUI
 	return nil
 }
 
+func SendButtonClick(socket *websocket.Conn, roomId uuid.UUID, messageId uuid.UUID, buttonId string) error {
+
+	msgRq := &server.WSChatButtonClickRequest{
+		Type: server.EventButtonClick,
+		Data: server.WSChatButtonClickDataRequest{
+			RoomId:    roomId,
+			MessageId: messageId,
+			ButtonId:  buttonId,
+		},
+	}
+
+	request, err := json.Marshal(msgRq)
+	if err != nil {
+		return err
+	}
+
+	err = socket.WriteMessage(websocket.TextMessage, request)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func ReadMessages(conn *websocket.Conn,
 	readChan <-chan []byte,
 	roomId uuid.UUID,
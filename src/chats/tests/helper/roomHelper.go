@@ -1,5 +1,80 @@
 package helper
 
+import (
+	"bytes"
+	"chats/server"
+	"encoding/json"
+	"fmt"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+)
+
 func createRoom() {
 
 }
+
+func GetMessageHistory(roomId uuid.UUID, accountId uuid.UUID) (*server.GetMessageHistoryResponse, error) {
+
+	url := fmt.Sprintf("http://localhost:8000/api/v1/rooms/messages/history?roomId=%s&accountId=%s&pageSize=100&pageIndex=1",
+		roomId.String(), accountId.String())
+
+	rs, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Body.Close()
+
+	result := &server.GetMessageHistoryResponse{}
+	err = json.NewDecoder(rs.Body).Decode(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func JoinRoomByHash(hash string) (*server.JoinRoomByHashResponse, int, error) {
+
+	body, err := json.Marshal(&server.JoinRoomByHashRequest{Hash: hash})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rs, err := http.Post("http://localhost:8000/api/v1/rooms/join", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rs.Body.Close()
+
+	if rs.StatusCode != http.StatusOK {
+		return nil, rs.StatusCode, nil
+	}
+
+	result := &server.JoinRoomByHashResponse{}
+	err = json.NewDecoder(rs.Body).Decode(result)
+	if err != nil {
+		return nil, rs.StatusCode, err
+	}
+
+	return result, rs.StatusCode, nil
+}
+
+func GetStarredMessages(accountId uuid.UUID) (*server.GetMessageHistoryResponse, error) {
+
+	url := fmt.Sprintf("http://localhost:8000/api/v1/rooms/messages/history?accountId=%s&starredOnly=true&pageSize=100&pageIndex=1",
+		accountId.String())
+
+	rs, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Body.Close()
+
+	result := &server.GetMessageHistoryResponse{}
+	err = json.NewDecoder(rs.Body).Decode(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
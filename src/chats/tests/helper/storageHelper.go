@@ -0,0 +1,34 @@
+package helper
+
+import (
+	"chats/app"
+	uuid "github.com/satori/go.uuid"
+	"sync"
+	"time"
+)
+
+var appOnce sync.Once
+
+// InitApp connects to the storage the server uses to call the server's jobs directly
+func InitApp() {
+	appOnce.Do(func() {
+		app.ApplicationInit()
+	})
+}
+
+// AgeRoomMessages moves messages of the room and their statuses back in time
+func AgeRoomMessages(roomId uuid.UUID, age time.Duration) error {
+
+	InitApp()
+
+	db := app.GetDB().Instance
+
+	err := db.Exec(`update chat_messages set created_at = created_at - ? * interval '1 second' where room_id = ?::uuid`,
+		int64(age.Seconds()), roomId).Error
+	if err != nil {
+		return err
+	}
+
+	return db.Exec(`update chat_message_statuses s set created_at = s.created_at - ? * interval '1 second'
+		from chat_messages m where m.id = s.message_id and m.room_id = ?::uuid`, int64(age.Seconds()), roomId).Error
+}
@@ -8,9 +8,13 @@ import (
 )
 
 func AccountWebSocket(accountId uuid.UUID) (*websocket.Conn, chan []byte, error) {
+	return TokenWebSocket(accountId.String())
+}
+
+func TokenWebSocket(token string) (*websocket.Conn, chan []byte, error) {
 
 	header := http.Header{}
-	c, _, err := websocket.DefaultDialer.Dial( "ws://localhost:8000/ws/?token=" + accountId.String(), header)
+	c, _, err := websocket.DefaultDialer.Dial( "ws://localhost:8000/ws/?token=" + token, header)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	pb "chats/proto"
+	"chats/system"
+	"chats/tests/helper"
+	"context"
+	"testing"
+)
+
+func TestRoomWaitsInQueue_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	clientAccountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// a new queue with no operators available
+	queue := system.Uuid().String()
+
+	roomService := pb.NewRoomClient(conn)
+	r, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Queue:       queue,
+		Subscribers: []*pb.SubscriberRequest{
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(clientAccountId)},
+				Role:    "client",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(r.Errors) > 0 {
+		t.Fatal(r.Errors[0].Message)
+	}
+
+	queueService := pb.NewQueueClient(conn)
+	q, err := queueService.GetQueue(ctx, &pb.GetQueueRequest{Queue: queue})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(q.Errors) > 0 {
+		t.Fatal(q.Errors[0].Message)
+	}
+
+	if len(q.Items) != 1 || q.Items[0].RoomId.ToUUID() != r.Result.Id.ToUUID() {
+		t.Fatal("Room isn't found in the queue")
+	}
+
+	if q.Stats.Waiting != 1 || q.Stats.AvailableOperators != 0 {
+		t.Fatal("Incorrect queue stats")
+	}
+
+}
+
+func TestSetOperatorAvailability_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	operatorAccountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	queue := system.Uuid().String()
+	queueService := pb.NewQueueClient(conn)
+
+	rs, err := queueService.SetOperatorAvailability(ctx, &pb.SetOperatorAvailabilityRequest{
+		Queue:     queue,
+		Account:   &pb.AccountIdRequest{AccountId: pb.FromUUID(operatorAccountId)},
+		Available: true,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rs.Errors) > 0 {
+		t.Fatal(rs.Errors[0].Message)
+	}
+
+	q, err := queueService.GetQueue(ctx, &pb.GetQueueRequest{Queue: queue})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if q.Stats.AvailableOperators != 1 {
+		t.Fatal("Operator isn't available")
+	}
+
+}
@@ -0,0 +1,204 @@
+package tests
+
+import (
+	"chats/app"
+	pb "chats/proto"
+	"chats/server"
+	"chats/system"
+	"chats/tests/helper"
+	"context"
+	uuid "github.com/satori/go.uuid"
+	"testing"
+	"time"
+)
+
+func TestRetentionPurge_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	clientId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	operatorId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the unique prefix keeps the policies away from other tests' rooms
+	prefix := "retention-" + system.Uuid().String() + "-"
+
+	createRoom := func(referenceId string) uuid.UUID {
+
+		rs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+			ReferenceId: referenceId,
+			Chat:        true,
+			Subscribers: []*pb.SubscriberRequest{
+				{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(clientId)}, Role: "client"},
+				{Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(operatorId)}, Role: "operator"},
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(rs.Errors) > 0 {
+			t.Fatal(rs.Errors[0].Message)
+		}
+		roomId := rs.Result.Id.ToUUID()
+
+		sendRs, err := roomService.SendChatMessages(ctx, &pb.SendChatMessagesRequest{
+			SenderAccountId: pb.FromUUID(operatorId),
+			Type:            server.EventMessage,
+			Data: &pb.SendChatMessagesDataRequest{Messages: []*pb.SendChatMessageDataRequest{
+				{RoomId: pb.FromUUID(roomId), Type: "message", Text: "старое сообщение"},
+			}},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(sendRs.Errors) > 0 {
+			t.Fatal(sendRs.Errors[0].Message)
+		}
+
+		return roomId
+	}
+
+	purgedRoomId := createRoom(prefix + "purged")
+
+	history, err := helper.GetMessageHistory(purgedRoomId, clientId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(history.Messages) != 1 {
+		t.Fatal("Message must be found")
+	}
+	messageId := history.Messages[0].Id
+
+	pinRs, err := roomService.PinMessage(ctx, &pb.PinMessageRequest{
+		RoomId:             pb.FromUUID(purgedRoomId),
+		MessageId:          pb.FromUUID(messageId),
+		InitiatorAccountId: pb.FromUUID(operatorId),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(pinRs.Errors) > 0 {
+		t.Fatal(pinRs.Errors[0].Message)
+	}
+
+	starRs, err := roomService.StarMessage(ctx, &pb.StarMessageRequest{
+		AccountId: pb.FromUUID(clientId),
+		MessageId: pb.FromUUID(messageId),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(starRs.Errors) > 0 {
+		t.Fatal(starRs.Errors[0].Message)
+	}
+
+	reportRs, err := roomService.ReportMessage(ctx, &pb.ReportMessageRequest{
+		AccountId: pb.FromUUID(clientId),
+		MessageId: pb.FromUUID(messageId),
+		Reason:    "спам",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(reportRs.Errors) > 0 {
+		t.Fatal(reportRs.Errors[0].Message)
+	}
+
+	// the previous room is closed on creating a new one, it doesn't affect the retention
+	keptRoomId := createRoom(prefix + "kept")
+
+	for _, roomId := range []uuid.UUID{purgedRoomId, keptRoomId} {
+		if err := helper.AgeRoomMessages(roomId, time.Hour*24*10); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	// the first matching policy is applied, so the kept room is excepted from the second one
+	policies := []app.RetentionPolicy{
+		{ReferencePrefix: prefix + "kept"},
+		{ReferencePrefix: prefix, MessagesDays: 7},
+	}
+
+	// the dry run only counts the messages to be deleted
+	result, sysErr := server.PurgeByRetention(policies, time.Now(), true)
+	if sysErr != nil {
+		t.Fatal(sysErr.Message)
+	}
+	if result.Messages != 1 {
+		t.Fatalf("Dry run must count one message, counted %d", result.Messages)
+	}
+
+	history, err = helper.GetMessageHistory(purgedRoomId, clientId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(history.Messages) != 1 {
+		t.Fatal("Dry run must not delete messages")
+	}
+
+	result, sysErr = server.PurgeByRetention(policies, time.Now(), false)
+	if sysErr != nil {
+		t.Fatal(sysErr.Message)
+	}
+	if result.Messages != 1 {
+		t.Fatalf("One message must be deleted, deleted %d", result.Messages)
+	}
+
+	history, err = helper.GetMessageHistory(purgedRoomId, clientId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(history.Messages) != 0 {
+		t.Fatal("Outdated message must be deleted")
+	}
+
+	history, err = helper.GetMessageHistory(keptRoomId, clientId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(history.Messages) != 1 {
+		t.Fatal("Messages of the excepted room must be kept")
+	}
+
+	starred, err := helper.GetStarredMessages(clientId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(starred.Messages) != 0 {
+		t.Fatal("Stars of the deleted message must be deleted")
+	}
+
+	rooms, err := roomService.GetByCriteria(ctx, &pb.GetRoomsByCriteriaRequest{RoomId: pb.FromUUID(purgedRoomId), WithClosed: true})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rooms.Rooms) != 1 || len(rooms.Rooms[0].Pins) != 0 {
+		t.Fatal("Pins of the deleted message must be deleted")
+	}
+
+	reports, err := roomService.GetMessageReports(ctx, &pb.GetMessageReportsRequest{RoomId: pb.FromUUID(purgedRoomId)})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(reports.Errors) > 0 {
+		t.Fatal(reports.Errors[0].Message)
+	}
+	if len(reports.Messages) != 0 {
+		t.Fatal("Reports of the deleted message must be deleted")
+	}
+
+}
@@ -0,0 +1,295 @@
+package tests
+
+import (
+	pb "chats/proto"
+	"chats/system"
+	"chats/tests/helper"
+	"context"
+	"testing"
+)
+
+func TestSetRole_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	code := system.Uuid().String()
+
+	roleService := pb.NewRoleClient(conn)
+	setRs, err := roleService.SetRole(ctx, &pb.SetRoleRequest{
+		Role: &pb.SubscriberRole{
+			Code:         code,
+			Description:  "test role",
+			Capabilities: []string{"send", "invite"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(setRs.Errors) > 0 {
+		t.Fatal(setRs.Errors[0].Message)
+	}
+
+	rolesRs, err := roleService.GetRoles(ctx, &pb.GetRolesRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rolesRs.Errors) > 0 {
+		t.Fatal(rolesRs.Errors[0].Message)
+	}
+
+	found := false
+	for _, r := range rolesRs.Roles {
+		if r.Code == code {
+			found = true
+			if len(r.Capabilities) != 2 {
+				t.Fatal("Incorrect role capabilities")
+			}
+		}
+	}
+	if !found {
+		t.Fatal("Role isn't found")
+	}
+
+	delRs, err := roleService.DeleteRole(ctx, &pb.DeleteRoleRequest{Code: code})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(delRs.Errors) > 0 {
+		t.Fatal(delRs.Errors[0].Message)
+	}
+
+}
+
+func TestObserverCannotSend_Fail(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	clientAccountId, _, err := helper.CreateDefaultAccount(conn)
+	observerAccountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	roomService := pb.NewRoomClient(conn)
+	r, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Subscribers: []*pb.SubscriberRequest{
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(clientAccountId)},
+				Role:    "client",
+			},
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(observerAccountId)},
+				Role:    "observer",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(r.Errors) > 0 {
+		t.Fatal(r.Errors[0].Message)
+	}
+
+	rs, err := roomService.SendChatMessages(ctx, &pb.SendChatMessagesRequest{
+		SenderAccountId: pb.FromUUID(observerAccountId),
+		Type:            "message",
+		Data: &pb.SendChatMessagesDataRequest{
+			Messages: []*pb.SendChatMessageDataRequest{
+				{
+					ClientMessageId: system.Uuid().String(),
+					RoomId:          r.Result.Id,
+					Type:            "text",
+					Text:            "hello",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(rs.Errors) == 0 || rs.Errors[0].Code != system.CapabilityDeniedCode {
+		t.Fatal("Observer mustn't be able to send messages")
+	}
+
+}
+
+func TestPromoteObserver_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	clientAccountId, _, err := helper.CreateDefaultAccount(conn)
+	supervisorAccountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	roomService := pb.NewRoomClient(conn)
+	r, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Subscribers: []*pb.SubscriberRequest{
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(clientAccountId)},
+				Role:    "client",
+			},
+			{
+				Account:    &pb.AccountIdRequest{AccountId: pb.FromUUID(supervisorAccountId)},
+				AsObserver: true,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(r.Errors) > 0 {
+		t.Fatal(r.Errors[0].Message)
+	}
+
+	sendRq := &pb.SendChatMessagesRequest{
+		SenderAccountId: pb.FromUUID(supervisorAccountId),
+		Type:            "message",
+		Data: &pb.SendChatMessagesDataRequest{
+			Messages: []*pb.SendChatMessageDataRequest{
+				{
+					ClientMessageId: system.Uuid().String(),
+					RoomId:          r.Result.Id,
+					Type:            "text",
+					Text:            "hello",
+				},
+			},
+		},
+	}
+
+	rs, err := roomService.SendChatMessages(ctx, sendRq)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rs.Errors) == 0 || rs.Errors[0].Code != system.ObserverCannotSendCode {
+		t.Fatal("Observer mustn't be able to send messages")
+	}
+
+	prRs, err := roomService.PromoteObserver(ctx, &pb.PromoteObserverRequest{
+		RoomId:  r.Result.Id,
+		Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(supervisorAccountId)},
+		Role:    "operator",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(prRs.Errors) > 0 {
+		t.Fatal(prRs.Errors[0].Message)
+	}
+
+	rs, err = roomService.SendChatMessages(ctx, sendRq)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rs.Errors) > 0 {
+		t.Fatal(rs.Errors[0].Message)
+	}
+
+}
+
+func TestInternalNoteHiddenFromClientHistory_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	clientAccountId, _, err := helper.CreateDefaultAccount(conn)
+	operatorAccountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	roomService := pb.NewRoomClient(conn)
+	r, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Subscribers: []*pb.SubscriberRequest{
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(clientAccountId)},
+				Role:    "client",
+			},
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(operatorAccountId)},
+				Role:    "operator",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(r.Errors) > 0 {
+		t.Fatal(r.Errors[0].Message)
+	}
+
+	rs, err := roomService.SendChatMessages(ctx, &pb.SendChatMessagesRequest{
+		SenderAccountId: pb.FromUUID(operatorAccountId),
+		Type:            "message",
+		Data: &pb.SendChatMessagesDataRequest{
+			Messages: []*pb.SendChatMessageDataRequest{
+				{
+					ClientMessageId: system.Uuid().String(),
+					RoomId:          r.Result.Id,
+					Type:            "text",
+					Text:            "internal note",
+					Visibility:      "roles",
+					VisibleRoles:    []string{"operator", "admin"},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rs.Errors) > 0 {
+		t.Fatal(rs.Errors[0].Message)
+	}
+
+	clientHistory, err := helper.GetMessageHistory(r.Result.Id.ToUUID(), clientAccountId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(clientHistory.Messages) != 0 {
+		t.Fatal("Internal note mustn't be visible to the client")
+	}
+
+	operatorHistory, err := helper.GetMessageHistory(r.Result.Id.ToUUID(), operatorAccountId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(operatorHistory.Messages) != 1 {
+		t.Fatal("Internal note must be visible to the operator")
+	}
+
+}
@@ -2,11 +2,15 @@ package tests
 
 import (
 	pb "chats/proto"
+	"chats/server"
 	"chats/system"
 	"chats/tests/helper"
 	"context"
 	"log"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestCreateRoomWithTwoSubscribers_Success(t *testing.T) {
@@ -296,4 +300,862 @@ func TestFirstRoomClosedAfterSecondCreated_Success(t *testing.T) {
 	}
 	log.Println(getRs)
 
-}
\ No newline at end of file
+}
+
+func TestTransferRoom_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	clientAccountId, _, err := helper.CreateDefaultAccount(conn)
+	firstOperatorId, _, err := helper.CreateDefaultAccount(conn)
+	secondOperatorId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Subscribers: []*pb.SubscriberRequest{
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(clientAccountId)},
+				Role:    "client",
+			},
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(firstOperatorId)},
+				Role:    "operator",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	trRs, err := roomService.Transfer(ctx, &pb.TransferRoomRequest{
+		RoomId:      r.Result.Id,
+		FromAccount: &pb.AccountIdRequest{AccountId: pb.FromUUID(firstOperatorId)},
+		ToAccount:   &pb.AccountIdRequest{AccountId: pb.FromUUID(secondOperatorId)},
+		Reason:      "смена оператора",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(trRs.Errors) > 0 {
+		t.Fatal(trRs.Errors[0].Message)
+	}
+
+	rooms, err := roomService.GetByCriteria(ctx, &pb.GetRoomsByCriteriaRequest{
+		RoomId:          r.Result.Id,
+		WithSubscribers: true,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(rooms.Rooms) != 1 || rooms.Rooms[0].ClosedAt != nil {
+		t.Fatal("Room must stay open")
+	}
+
+	secondOperatorFound := false
+	for _, s := range rooms.Rooms[0].Subscribers {
+		if s.AccountId.ToUUID() == firstOperatorId {
+			t.Fatal("Previous operator is still subscribed")
+		}
+		if s.AccountId.ToUUID() == secondOperatorId {
+			secondOperatorFound = s.Role == "operator"
+		}
+	}
+
+	if !secondOperatorFound {
+		t.Fatal("New operator isn't subscribed with the operator role")
+	}
+
+}
+
+func TestCreateDirectRoom_ReturnsExistent(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	firstAccountId, _, err := helper.CreateDefaultAccount(conn)
+	secondAccountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rq := &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Type:        "direct",
+		Subscribers: []*pb.SubscriberRequest{
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(firstAccountId)},
+				Role:    "member",
+			},
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(secondAccountId)},
+				Role:    "member",
+			},
+		},
+	}
+
+	first, err := roomService.Create(ctx, rq)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(first.Errors) > 0 {
+		t.Fatal(first.Errors[0].Message)
+	}
+
+	second, err := roomService.Create(ctx, rq)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(second.Errors) > 0 {
+		t.Fatal(second.Errors[0].Message)
+	}
+
+	if first.Result.Id.Value != second.Result.Id.Value {
+		t.Fatal("Existent direct room must be returned")
+	}
+
+	rq.Subscribers = rq.Subscribers[:1]
+	third, err := roomService.Create(ctx, rq)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(third.Errors) == 0 {
+		t.Fatal("Direct room with one subscriber must be rejected")
+	}
+
+}
+
+func TestJoinRoomByOneTimeHash_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	operatorAccountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		HashOneTime: true,
+		Subscribers: []*pb.SubscriberRequest{
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(operatorAccountId)},
+				Role:    "operator",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rs.Errors) > 0 {
+		t.Fatal(rs.Errors[0].Message)
+	}
+	if rs.Result.Hash == "" {
+		t.Fatal("Room hash must be generated")
+	}
+
+	joinRs, status, err := helper.JoinRoomByHash(rs.Result.Hash)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if joinRs == nil {
+		t.Fatalf("Join by hash failed with status %d", status)
+	}
+	if joinRs.RoomId.String() != rs.Result.Id.Value || joinRs.Token == "" || joinRs.Token == joinRs.AccountId.String() {
+		t.Fatal("Incorrect join response")
+	}
+
+	ws, _, err := helper.TokenWebSocket(joinRs.Token)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer ws.Close()
+
+	_, status, err = helper.JoinRoomByHash(rs.Result.Hash)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if status == http.StatusOK {
+		t.Fatal("One-time hash must not be used twice")
+	}
+
+}
+
+func TestRoomClosedByInactivity_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	accountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId:       system.Uuid().String(),
+		Chat:              true,
+		InactivityTimeout: 1,
+		Subscribers: []*pb.SubscriberRequest{
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(accountId)},
+				Role:    "client",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rs.Errors) > 0 {
+		t.Fatal(rs.Errors[0].Message)
+	}
+
+	ws, readChan, err := helper.AccountWebSocket(accountId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer ws.Close()
+
+	timeout := time.After(time.Second * 30)
+	for {
+		select {
+		case msg := <-readChan:
+			if strings.Contains(string(msg), `"type":"roomClosed"`) {
+				return
+			}
+		case <-timeout:
+			t.Fatal("Room must be closed by inactivity")
+		}
+	}
+
+}
+
+func TestRoomClosedAtScheduledTime_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	accountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// no inactivity timeout, the room is closed at the scheduled time only
+	closeAt := time.Now().Add(time.Second * 10)
+
+	rs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		CloseAt:     pb.ToTimestamp(&closeAt),
+		Subscribers: []*pb.SubscriberRequest{
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(accountId)},
+				Role:    "client",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rs.Errors) > 0 {
+		t.Fatal(rs.Errors[0].Message)
+	}
+
+	ws, readChan, err := helper.AccountWebSocket(accountId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer ws.Close()
+
+	timeout := time.After(time.Second * 40)
+	for {
+		select {
+		case msg := <-readChan:
+			if strings.Contains(string(msg), `"type":"roomClosed"`) {
+				if time.Now().Before(closeAt) {
+					t.Fatal("Room must not be closed before the scheduled time")
+				}
+				return
+			}
+		case <-timeout:
+			t.Fatal("Room must be closed at the scheduled time")
+		}
+	}
+
+}
+
+func TestSubscriberJoinedEvent_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	clientAccountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	operatorAccountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Subscribers: []*pb.SubscriberRequest{
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(clientAccountId)},
+				Role:    "client",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rs.Errors) > 0 {
+		t.Fatal(rs.Errors[0].Message)
+	}
+
+	ws, readChan, err := helper.AccountWebSocket(clientAccountId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer ws.Close()
+
+	time.Sleep(time.Second)
+
+	subscribeRs, err := roomService.Subscribe(ctx, &pb.RoomSubscribeRequest{
+		RoomId: rs.Result.Id,
+		Subscribers: []*pb.SubscriberRequest{
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(operatorAccountId)},
+				Role:    "operator",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(subscribeRs.Errors) > 0 {
+		t.Fatal(subscribeRs.Errors[0].Message)
+	}
+
+	timeout := time.After(time.Second * 10)
+	for {
+		select {
+		case msg := <-readChan:
+			if strings.Contains(string(msg), `"type":"subscriberJoined"`) &&
+				strings.Contains(string(msg), operatorAccountId.String()) {
+				return
+			}
+		case <-timeout:
+			t.Fatal("subscriberJoined event must be received")
+		}
+	}
+
+}
+
+func TestReopenAndArchiveRoom_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	accountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Subscribers: []*pb.SubscriberRequest{
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(accountId)},
+				Role:    "client",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rs.Errors) > 0 {
+		t.Fatal(rs.Errors[0].Message)
+	}
+
+	closeRs, err := roomService.CloseRoom(ctx, &pb.CloseRoomRequest{RoomId: rs.Result.Id})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(closeRs.Errors) > 0 {
+		t.Fatal(closeRs.Errors[0].Message)
+	}
+
+	reopenRs, err := roomService.ReopenRoom(ctx, &pb.ReopenRoomRequest{RoomId: rs.Result.Id})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(reopenRs.Errors) > 0 {
+		t.Fatal(reopenRs.Errors[0].Message)
+	}
+
+	rooms, err := roomService.GetByCriteria(ctx, &pb.GetRoomsByCriteriaRequest{RoomId: rs.Result.Id})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rooms.Rooms) != 1 {
+		t.Fatal("Reopened room must be found among open rooms")
+	}
+
+	closeRs, err = roomService.CloseRoom(ctx, &pb.CloseRoomRequest{RoomId: rs.Result.Id})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(closeRs.Errors) > 0 {
+		t.Fatal(closeRs.Errors[0].Message)
+	}
+
+	archiveRs, err := roomService.ArchiveRoom(ctx, &pb.ArchiveRoomRequest{RoomId: rs.Result.Id})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(archiveRs.Errors) > 0 {
+		t.Fatal(archiveRs.Errors[0].Message)
+	}
+
+	rooms, err = roomService.GetByCriteria(ctx, &pb.GetRoomsByCriteriaRequest{RoomId: rs.Result.Id, WithClosed: true})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rooms.Rooms) != 0 {
+		t.Fatal("Archived room must be hidden by default")
+	}
+
+	rooms, err = roomService.GetByCriteria(ctx, &pb.GetRoomsByCriteriaRequest{RoomId: rs.Result.Id, WithClosed: true, WithArchived: true})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rooms.Rooms) != 1 || rooms.Rooms[0].ArchivedAt == nil {
+		t.Fatal("Archived room must be found with archived ones")
+	}
+
+	reopenRs, err = roomService.ReopenRoom(ctx, &pb.ReopenRoomRequest{RoomId: rs.Result.Id})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(reopenRs.Errors) == 0 {
+		t.Fatal("Archived room must not be reopened")
+	}
+
+}
+
+func TestUpdateRoomMetadataAndFilterByTags_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	accountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tag := system.Uuid().String()
+
+	rs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+		ReferenceId: system.Uuid().String(),
+		Chat:        true,
+		Title:       "Заказ",
+		Tags:        []string{tag},
+		Subscribers: []*pb.SubscriberRequest{
+			{
+				Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(accountId)},
+				Role:    "client",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rs.Errors) > 0 {
+		t.Fatal(rs.Errors[0].Message)
+	}
+
+	updateRs, err := roomService.UpdateRoom(ctx, &pb.UpdateRoomRequest{
+		RoomId:     rs.Result.Id,
+		Title:      &pb.StringValue{Value: "Заказ 123"},
+		Attributes: &pb.StringValue{Value: `{"orderNumber": "123"}`},
+		Tags:       &pb.StringList{Values: []string{tag, "vip"}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(updateRs.Errors) > 0 {
+		t.Fatal(updateRs.Errors[0].Message)
+	}
+
+	rooms, err := roomService.GetByCriteria(ctx, &pb.GetRoomsByCriteriaRequest{Tags: []string{tag, "vip"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rooms.Rooms) != 1 || rooms.Rooms[0].Title != "Заказ 123" {
+		t.Fatal("Updated room must be found by tags")
+	}
+
+	// omitted fields keep their values
+	updateRs, err = roomService.UpdateRoom(ctx, &pb.UpdateRoomRequest{
+		RoomId:      rs.Result.Id,
+		Description: &pb.StringValue{Value: "Доставка"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(updateRs.Errors) > 0 {
+		t.Fatal(updateRs.Errors[0].Message)
+	}
+
+	rooms, err = roomService.GetByCriteria(ctx, &pb.GetRoomsByCriteriaRequest{Tags: []string{tag, "vip"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rooms.Rooms) != 1 || rooms.Rooms[0].Title != "Заказ 123" || rooms.Rooms[0].Description != "Доставка" {
+		t.Fatal("Omitted fields must not be changed")
+	}
+
+	updateRs, err = roomService.UpdateRoom(ctx, &pb.UpdateRoomRequest{
+		RoomId:     rs.Result.Id,
+		Attributes: &pb.StringValue{Value: `["123"]`},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(updateRs.Errors) == 0 {
+		t.Fatal("Attributes must be a JSON object")
+	}
+
+}
+
+func TestGetAccountRoomsSortedByActivity_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	accountId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	operatorId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var roomIds []*pb.UUID
+	for i := 0; i < 2; i++ {
+		rs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+			ReferenceId: system.Uuid().String(),
+			Chat:        true,
+			Subscribers: []*pb.SubscriberRequest{
+				{
+					Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(accountId)},
+					Role:    "client",
+				},
+				{
+					Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(operatorId)},
+					Role:    "operator",
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(rs.Errors) > 0 {
+			t.Fatal(rs.Errors[0].Message)
+		}
+		roomIds = append(roomIds, rs.Result.Id)
+	}
+
+	// the message makes the first room the most recently active one
+	sendRs, err := roomService.SendChatMessages(ctx, &pb.SendChatMessagesRequest{
+		SenderAccountId: pb.FromUUID(operatorId),
+		Type:            server.EventMessage,
+		Data: &pb.SendChatMessagesDataRequest{Messages: []*pb.SendChatMessageDataRequest{
+			{
+				RoomId: roomIds[0],
+				Type:   "message",
+				Text:   "добрый день",
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(sendRs.Errors) > 0 {
+		t.Fatal(sendRs.Errors[0].Message)
+	}
+
+	rq := &pb.GetAccountRoomsRequest{
+		AccountId:  &pb.AccountIdRequest{AccountId: pb.FromUUID(accountId)},
+		WithClosed: true,
+		Limit:      1,
+	}
+
+	page, err := roomService.GetAccountRooms(ctx, rq)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(page.Errors) > 0 {
+		t.Fatal(page.Errors[0].Message)
+	}
+
+	if len(page.Rooms) != 1 || page.Rooms[0].Id.ToUUID() != roomIds[0].ToUUID() {
+		t.Fatal("Room with the last message must be the first one")
+	}
+	room := page.Rooms[0]
+	if room.LastMessage == nil || room.LastMessage.Text != "добрый день" || room.LastMessage.SenderAccountId.ToUUID() != operatorId {
+		t.Fatal("Last message preview is wrong")
+	}
+	if room.UnreadCount != 1 {
+		t.Fatalf("Unread count must be 1, got %d", room.UnreadCount)
+	}
+	if len(room.Subscribers) != 2 {
+		t.Fatal("Room subscribers must be returned")
+	}
+	if page.NextCursor == "" {
+		t.Fatal("Next cursor must be returned")
+	}
+
+	rq.Cursor = page.NextCursor
+	page, err = roomService.GetAccountRooms(ctx, rq)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(page.Errors) > 0 {
+		t.Fatal(page.Errors[0].Message)
+	}
+
+	if len(page.Rooms) != 1 || page.Rooms[0].Id.ToUUID() != roomIds[1].ToUUID() || page.Rooms[0].LastMessage != nil {
+		t.Fatal("Second page must contain the room without messages")
+	}
+
+}
+
+func TestSessionInSeveralRooms_Success(t *testing.T) {
+
+	conn, err := helper.GrpcConnection()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+
+	// the supervisor observes several rooms at a time, so none of them is closed by the others
+	supervisorId, _, err := helper.CreateDefaultAccount(conn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	roomService := pb.NewRoomClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var roomIds, clientIds []*pb.UUID
+	for i := 0; i < 3; i++ {
+
+		clientId, _, err := helper.CreateDefaultAccount(conn)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		rs, err := roomService.Create(ctx, &pb.CreateRoomRequest{
+			ReferenceId: system.Uuid().String(),
+			Chat:        true,
+			Subscribers: []*pb.SubscriberRequest{
+				{
+					Account: &pb.AccountIdRequest{AccountId: pb.FromUUID(clientId)},
+					Role:    "client",
+				},
+				{
+					Account:    &pb.AccountIdRequest{AccountId: pb.FromUUID(supervisorId)},
+					AsObserver: true,
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(rs.Errors) > 0 {
+			t.Fatal(rs.Errors[0].Message)
+		}
+
+		roomIds = append(roomIds, rs.Result.Id)
+		clientIds = append(clientIds, pb.FromUUID(clientId))
+	}
+
+	ws, readChan, err := helper.AccountWebSocket(supervisorId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer ws.Close()
+
+	time.Sleep(time.Second)
+
+	// close a subset of the rooms
+	for _, roomId := range roomIds[:2] {
+		closeRs, err := roomService.CloseRoom(ctx, &pb.CloseRoomRequest{RoomId: roomId})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(closeRs.Errors) > 0 {
+			t.Fatal(closeRs.Errors[0].Message)
+		}
+	}
+
+	closed := map[string]bool{}
+	timeout := time.After(time.Second * 10)
+	for len(closed) < 2 {
+		select {
+		case msg := <-readChan:
+			if strings.Contains(string(msg), `"type":"roomClosed"`) {
+				for _, roomId := range roomIds {
+					if strings.Contains(string(msg), roomId.ToUUID().String()) {
+						closed[roomId.ToUUID().String()] = true
+					}
+				}
+			}
+		case <-timeout:
+			t.Fatal("roomClosed events must be received for the closed rooms")
+		}
+	}
+	if closed[roomIds[2].ToUUID().String()] {
+		t.Fatal("Open room mustn't be closed")
+	}
+
+	sendText := func(text string) {
+		rs, err := roomService.SendChatMessages(ctx, &pb.SendChatMessagesRequest{
+			SenderAccountId: clientIds[2],
+			Type:            server.EventMessage,
+			Data: &pb.SendChatMessagesDataRequest{Messages: []*pb.SendChatMessageDataRequest{
+				{
+					ClientMessageId: system.Uuid().String(),
+					RoomId:          roomIds[2],
+					Type:            "text",
+					Text:            text,
+				},
+			}},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(rs.Errors) > 0 {
+			t.Fatal(rs.Errors[0].Message)
+		}
+	}
+
+	// the session still receives messages of the open room
+	sendText("before unsubscribe")
+	timeout = time.After(time.Second * 10)
+	for received := false; !received; {
+		select {
+		case msg := <-readChan:
+			received = strings.Contains(string(msg), "before unsubscribe")
+		case <-timeout:
+			t.Fatal("Message of the open room must be received")
+		}
+	}
+
+	unsubscribeRs, err := roomService.Unsubscribe(ctx, &pb.RoomUnsubscribeRequest{
+		RoomId:    roomIds[2],
+		AccountId: &pb.AccountIdRequest{AccountId: pb.FromUUID(supervisorId)},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(unsubscribeRs.Errors) > 0 {
+		t.Fatal(unsubscribeRs.Errors[0].Message)
+	}
+
+	time.Sleep(time.Second)
+
+	// the session has left the last room
+	sendText("after unsubscribe")
+	timeout = time.After(time.Second * 5)
+	for {
+		select {
+		case msg := <-readChan:
+			if strings.Contains(string(msg), "after unsubscribe") {
+				t.Fatal("Message mustn't be received after unsubscribing")
+			}
+		case <-timeout:
+			return
+		}
+	}
+
+}